@@ -8,8 +8,10 @@ import (
 	"github.com/gembaadvantage/uplift/internal/semver"
 	"github.com/gembaadvantage/uplift/internal/task"
 	"github.com/gembaadvantage/uplift/internal/task/bump"
+	"github.com/gembaadvantage/uplift/internal/task/fetchtag"
 	"github.com/gembaadvantage/uplift/internal/task/gitcheck"
 	"github.com/gembaadvantage/uplift/internal/task/gitcommit"
+	"github.com/gembaadvantage/uplift/internal/task/gitpush"
 	"github.com/gembaadvantage/uplift/internal/task/gpgimport"
 	"github.com/gembaadvantage/uplift/internal/task/hook/after"
 	"github.com/gembaadvantage/uplift/internal/task/hook/afterbump"
@@ -17,6 +19,10 @@ import (
 	"github.com/gembaadvantage/uplift/internal/task/hook/beforebump"
 	"github.com/gembaadvantage/uplift/internal/task/nextcommit"
 	"github.com/gembaadvantage/uplift/internal/task/nextsemver"
+	"github.com/gembaadvantage/uplift/internal/task/plugin"
+	"github.com/gembaadvantage/uplift/internal/task/sshimport"
+	"github.com/gembaadvantage/uplift/internal/task/summary"
+	"github.com/gembaadvantage/uplift/internal/task/verifysig"
 	"github.com/spf13/cobra"
 )
 
@@ -42,11 +48,16 @@ uplift bump --prerelease beta.1
 
 # Bump (patch) all configured files but do not stage or push any changes
 # back to the git remote
-uplift bump --no-stage`
+uplift bump --no-stage
+
+# Preview how all configured files would be bumped (patched) as a unified diff
+uplift bump --dry-run`
 )
 
 type bumpOptions struct {
 	Prerelease string
+	ShowDiff   bool
+	FetchTags  bool
 	*globalOptions
 }
 
@@ -75,6 +86,8 @@ func newBumpCmd(gopts *globalOptions, out io.Writer) *bumpCommand {
 
 	f := cmd.Flags()
 	f.StringVar(&bmpCmd.Opts.Prerelease, "prerelease", "", "append a prerelease suffix to next calculated semantic version")
+	f.BoolVar(&bmpCmd.Opts.ShowDiff, "diff", false, "show a unified diff of all bumped files")
+	f.BoolVar(&bmpCmd.Opts.FetchTags, "fetch-all", false, "fetch all tags from the remote repository")
 
 	bmpCmd.Cmd = cmd
 	return bmpCmd
@@ -89,21 +102,34 @@ func bumpFiles(opts bumpOptions, out io.Writer) error {
 	tasks := []task.Runner{
 		gitcheck.Task{},
 		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
 		gpgimport.Task{},
+		sshimport.Task{},
+		fetchtag.Task{},
 		nextsemver.Task{},
+		verifysig.Task{},
 		nextcommit.Task{},
 		beforebump.Task{},
+		plugin.Task{Stage: plugin.StageBeforeBump},
 		bump.Task{},
 		afterbump.Task{},
+		plugin.Task{Stage: plugin.StageAfterBump},
 		gitcommit.Task{},
+		gitpush.Task{},
 		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
+		summary.Task{},
+	}
+
+	if tasks, err = composePipeline(ctx.Config, "bump", tasks); err != nil {
+		return err
 	}
 
-	return task.Execute(ctx, tasks)
+	return task.ExecuteWithHooks(ctx, tasks)
 }
 
 func setupBumpContext(opts bumpOptions, out io.Writer) (*context.Context, error) {
-	cfg, err := loadConfig(opts.ConfigDir)
+	cfg, err := loadConfig(opts.Config, opts.ConfigDir)
 	if err != nil {
 		fmt.Printf("failed to load uplift config. %v", err)
 		return nil, err
@@ -112,9 +138,13 @@ func setupBumpContext(opts bumpOptions, out io.Writer) (*context.Context, error)
 
 	// Set all values within the context
 	ctx.Debug = opts.Debug
+	ctx.TraceFile = opts.TraceFile
+	ctx.TraceEndpoint = opts.TraceEndpoint
 	ctx.DryRun = opts.DryRun
 	ctx.NoPush = opts.NoPush
 	ctx.NoStage = opts.NoStage
+	ctx.ShowDiff = opts.ShowDiff
+	ctx.FetchTags = opts.FetchTags
 	ctx.Out = out
 
 	// Handle prerelease suffix if one is provided
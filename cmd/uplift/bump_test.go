@@ -1,9 +1,12 @@
 package main
 
 import (
+	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/gembaadvantage/uplift/internal/ssh/sshtest"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,6 +46,59 @@ fix: a bug fix
 appVersion: 0.2.0`, string(actual))
 }
 
+func TestBump_FromSubdirectory(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: a new feature"),
+		gittest.WithCommittedFiles("test.txt", ".uplift.yml"),
+		gittest.WithFileContent("test.txt", bumpFile, ".uplift.yml", bumpConfig))
+
+	root, err := os.Getwd()
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll("nested/dir", 0o755))
+	t.Chdir("nested/dir")
+
+	bmpCmd := newBumpCmd(&globalOptions{ConfigDir: currentWorkingDir, NoPush: true}, os.Stdout)
+	err = bmpCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(filepath.Join(root, "test.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, `version: v0.1.0
+appVersion: v0.1.0`, string(actual))
+	assert.NoFileExists(t, filepath.Join(root, "nested", "dir", "test.txt"))
+}
+
+func TestBump_VerifySignaturesAfterFetchingTags(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: an unsigned feature"),
+		gittest.WithCommittedFiles("test.txt", ".uplift.yml"),
+		gittest.WithFileContent("test.txt", bumpFile, ".uplift.yml", bumpConfig+`verify:
+  signatures:
+    enabled: true
+`))
+
+	// Only the remote is aware of the latest tag, which excludes all unsigned commits
+	gittest.Tag(t, "0.1.0")
+	gittest.MustExec(t, "git push -q origin 0.1.0")
+	gittest.MustExec(t, "git tag -d 0.1.0")
+
+	sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "fix: a signed fix")
+
+	bmpCmd := newBumpCmd(noChangesPushed(), os.Stdout)
+	bmpCmd.Cmd.SetArgs([]string{"--fetch-all"})
+
+	err := bmpCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile("test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, `version: 0.1.1
+appVersion: 0.1.1`, string(actual))
+}
+
 func TestBump_PrereleaseFlag(t *testing.T) {
 	log := `docs: update docs
 fix: fix bug
@@ -79,3 +135,26 @@ func TestBump_Hooks(t *testing.T) {
 	assert.FileExists(t, AfterBumpFile)
 	assert.FileExists(t, AfterFile)
 }
+
+func TestBump_DiffFlag(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: a new feature"),
+		gittest.WithCommittedFiles("test.txt", ".uplift.yml"),
+		gittest.WithFileContent("test.txt", bumpFile, ".uplift.yml", bumpConfig))
+
+	var buf bytes.Buffer
+	bmpCmd := newBumpCmd(noChangesPushed(), &buf)
+	bmpCmd.Cmd.SetArgs([]string{"--diff"})
+
+	err := bmpCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.Equal(t, `--- a/test.txt
++++ b/test.txt
+@@ -1,2 +1,2 @@
+1   -version: 0.0.0
+2   -appVersion: 0.0.0
+  1 +version: v0.1.0
+  2 +appVersion: v0.1.0
+`, buf.String())
+}
@@ -10,12 +10,15 @@ import (
 	"github.com/gembaadvantage/uplift/internal/task/changelog"
 	"github.com/gembaadvantage/uplift/internal/task/gitcheck"
 	"github.com/gembaadvantage/uplift/internal/task/gitcommit"
+	"github.com/gembaadvantage/uplift/internal/task/gitpush"
 	"github.com/gembaadvantage/uplift/internal/task/hook/after"
 	"github.com/gembaadvantage/uplift/internal/task/hook/afterchangelog"
 	"github.com/gembaadvantage/uplift/internal/task/hook/before"
 	"github.com/gembaadvantage/uplift/internal/task/hook/beforechangelog"
 	"github.com/gembaadvantage/uplift/internal/task/nextcommit"
+	"github.com/gembaadvantage/uplift/internal/task/plugin"
 	"github.com/gembaadvantage/uplift/internal/task/scm"
+	"github.com/gembaadvantage/uplift/internal/task/verifysig"
 	git "github.com/purpleclay/gitz"
 	"github.com/spf13/cobra"
 )
@@ -76,6 +79,7 @@ type changelogOptions struct {
 	Multiline      bool
 	SkipPrerelease bool
 	TrimHeader     bool
+	FetchTags      bool
 	*globalOptions
 }
 
@@ -119,6 +123,7 @@ func newChangelogCmd(gopts *globalOptions, out io.Writer) *changelogCommand {
 	f.BoolVar(&chglogCmd.Opts.Multiline, "multiline", false, "include multiline commit messages within changelog (skips truncation)")
 	f.BoolVar(&chglogCmd.Opts.SkipPrerelease, "skip-prerelease", false, "skips the creation of a changelog entry for a prerelease")
 	f.BoolVar(&chglogCmd.Opts.TrimHeader, "trim-header", false, "strip any lines preceding the conventional commit type in the commit message")
+	f.BoolVar(&chglogCmd.Opts.FetchTags, "fetch-all", false, "fetch all tags from the remote repository")
 
 	chglogCmd.Cmd = cmd
 	return chglogCmd
@@ -132,17 +137,27 @@ func writeChangelog(opts changelogOptions, out io.Writer) error {
 
 	tasks := []task.Runner{
 		gitcheck.Task{},
+		verifysig.Task{},
 		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
 		scm.Task{},
 		nextcommit.Task{},
 		beforechangelog.Task{},
+		plugin.Task{Stage: plugin.StageBeforeChangelog},
 		changelog.Task{},
 		afterchangelog.Task{},
+		plugin.Task{Stage: plugin.StageAfterChangelog},
 		gitcommit.Task{},
+		gitpush.Task{},
 		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
 	}
 
-	return task.Execute(ctx, tasks)
+	if tasks, err = composePipeline(ctx.Config, "changelog", tasks); err != nil {
+		return err
+	}
+
+	return task.ExecuteWithHooks(ctx, tasks)
 }
 
 func writeChangelogDiff(opts changelogOptions, out io.Writer) error {
@@ -153,17 +168,25 @@ func writeChangelogDiff(opts changelogOptions, out io.Writer) error {
 
 	tasks := []task.Runner{
 		gitcheck.Task{},
+		verifysig.Task{},
 		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
 		scm.Task{},
 		changelog.Task{},
 		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
+	}
+
+	// A diff is customised separately, ensuring it never modifies the repository
+	if tasks, err = composePipeline(ctx.Config, "changelogDiff", tasks); err != nil {
+		return err
 	}
 
 	return task.Execute(ctx, tasks)
 }
 
 func setupChangelogContext(opts changelogOptions, out io.Writer) (*context.Context, error) {
-	cfg, err := loadConfig(opts.ConfigDir)
+	cfg, err := loadConfig(opts.Config, opts.ConfigDir)
 	if err != nil {
 		fmt.Printf("failed to load uplift config. %v", err)
 		return nil, err
@@ -173,6 +196,8 @@ func setupChangelogContext(opts changelogOptions, out io.Writer) (*context.Conte
 
 	// Set all values within the context
 	ctx.Debug = opts.Debug
+	ctx.TraceFile = opts.TraceFile
+	ctx.TraceEndpoint = opts.TraceEndpoint
 	ctx.DryRun = opts.DryRun
 	ctx.NoPush = opts.NoPush
 	ctx.NoStage = opts.NoStage
@@ -209,6 +234,13 @@ func setupChangelogContext(opts changelogOptions, out io.Writer) (*context.Conte
 	// By default ensure the ci(uplift): commits are excluded also
 	ctx.Changelog.Exclude = append(ctx.Changelog.Exclude, `ci\(uplift\)`)
 
+	// Tags must be fetched before the changelog versions can be resolved
+	if opts.FetchTags {
+		if _, err := ctx.GitClient.Fetch(git.WithAll(), git.WithTags()); err != nil {
+			return nil, err
+		}
+	}
+
 	if !ctx.Changelog.All {
 		// Attempt to retrieve the latest 2 tags for generating a changelog entry
 		tags, err := ctx.GitClient.Tags(git.WithShellGlob("*.*.*"),
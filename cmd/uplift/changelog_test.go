@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gembaadvantage/uplift/internal/ssh/sshtest"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,6 +40,38 @@ func TestChangelog_DiffOnly(t *testing.T) {
 	assert.Contains(t, buf.String(), "## 0.1.0")
 }
 
+func TestChangelog_VerifySignaturesAfterFetchingTags(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: an unsigned feature"),
+		gittest.WithCommittedFiles(".uplift.yml"),
+		gittest.WithFileContent(".uplift.yml", `verify:
+  signatures:
+    enabled: true
+`))
+	gittest.Tag(t, "0.1.0")
+	gittest.MustExec(t, "git push -q origin 0.1.0")
+
+	sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "fix: a signed fix")
+
+	// Only the remote is aware of the latest tags, which exclude all unsigned commits
+	gittest.Tag(t, "0.1.1")
+	gittest.MustExec(t, "git push -q origin 0.1.1")
+	gittest.MustExec(t, "git tag -d 0.1.0 0.1.1")
+
+	var buf bytes.Buffer
+
+	chglogCmd := newChangelogCmd(noChangesPushed(), &buf)
+	chglogCmd.Cmd.SetArgs([]string{"--diff-only", "--fetch-all"})
+
+	err := chglogCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "## 0.1.1")
+	assert.Contains(t, buf.String(), "fix: a signed fix")
+}
+
 func TestChangelog_WithExclude(t *testing.T) {
 	log := `(tag: 2.0.0) docs: some new docs
 ci: a ci task
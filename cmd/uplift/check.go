@@ -1,24 +1,77 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
 	"io"
+	"os"
+	"strings"
 
+	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/spf13/cobra"
 )
 
-func newCheckCmd(gopts *globalOptions, _ io.Writer) *cobra.Command {
+func newCheckCmd(gopts *globalOptions, out io.Writer) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "check",
 		Short: "Check if a configuration file is valid",
+		Long: `Check if a configuration file is valid. Once validated, the effective
+configuration is printed, after merging any base configurations it extends`,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			cfg, err := loadConfig(gopts.ConfigDir)
+			path, err := findConfig(gopts.Config, gopts.ConfigDir)
+			if err != nil || path == "" {
+				return err
+			}
+
+			data, err := resolveConfig(path)
 			if err != nil {
+				return checkSyntax(path, err)
+			}
+
+			if err := enterConfigDir(path, gopts.Config, gopts.ConfigDir); err != nil {
+				return err
+			}
+
+			cfg, err := config.Check(checkName(path, data), data)
+			if err != nil {
+				return err
+			}
+
+			if err := validatePipelines(cfg); err != nil {
 				return err
 			}
 
-			return cfg.Validate()
+			fmt.Fprint(out, string(data))
+			return nil
 		},
 	}
 
 	return cmd
 }
+
+// checkName identifies the config within any reported problem. If the config
+// extends any base configs, problems are reported against the effective config
+func checkName(path string, data []byte) string {
+	if raw, err := os.ReadFile(path); err == nil && !bytes.Equal(raw, data) {
+		return path + " (effective config)"
+	}
+	return path
+}
+
+// checkSyntax reports malformed YAML within the config file with a snippet
+// highlighting its position. Any other error is returned as is
+func checkSyntax(path string, err error) error {
+	if !strings.HasPrefix(err.Error(), "yaml: ") {
+		return err
+	}
+
+	raw, rerr := os.ReadFile(path)
+	if rerr != nil {
+		return err
+	}
+
+	if _, cerr := config.Check(path, raw); cerr != nil {
+		return cerr
+	}
+	return err
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"bytes"
+	"io"
 	"os"
 	"testing"
 
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCheck(t *testing.T) {
@@ -21,6 +24,19 @@ func TestCheck(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCheck_FromSubdirectory(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("test.txt"))
+	gittest.TempFile(t, ".uplift.yml", bumpConfig)
+
+	require.NoError(t, os.MkdirAll("nested", 0o755))
+	t.Chdir("nested")
+
+	checkCmd := newCheckCmd(&globalOptions{ConfigDir: currentWorkingDir}, io.Discard)
+	err := checkCmd.Execute()
+
+	assert.NoError(t, err)
+}
+
 func TestCheck_InvalidConfig(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.TempFile(t, ".uplift.yml", `bumps:
@@ -34,3 +50,67 @@ func TestCheck_InvalidConfig(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestCheck_PrintsEffectiveConfig(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "base.yml", `commitMessage: "ci: base"
+env:
+  - BASE=1
+`)
+	gittest.TempFile(t, ".uplift.yml", `extends: base.yml
+env:
+  - LOCAL=1
+`)
+
+	var buf bytes.Buffer
+	checkCmd := newCheckCmd(&globalOptions{ConfigDir: "."}, &buf)
+	err := checkCmd.Execute()
+
+	require.NoError(t, err)
+	assert.Equal(t, `commitMessage: "ci: base"
+env:
+  - BASE=1
+  - LOCAL=1
+`, buf.String())
+}
+
+func TestCheck_ReportsPosition(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", `changelog:
+  sort: up
+`)
+
+	checkCmd := newCheckCmd(&globalOptions{ConfigDir: "."}, io.Discard)
+	err := checkCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `field 'changelog.sort' contains a value that is not one of the following [asc desc ASC DESC]
+  --> .uplift.yml:2:9
+   |
+ 2 |   sort: up
+   |         ^`)
+}
+
+func TestCheck_SuggestsUnknownKey(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", `comitMessage: "ci: release"
+`)
+
+	checkCmd := newCheckCmd(&globalOptions{ConfigDir: "."}, io.Discard)
+	err := checkCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did you mean 'commitMessage'?")
+}
+
+func TestCheck_SyntaxError(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", `commitMessage: "ci: release
+`)
+
+	checkCmd := newCheckCmd(&globalOptions{ConfigDir: "."}, io.Discard)
+	err := checkCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--> .uplift.yml:")
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/apex/log"
 	"github.com/gembaadvantage/uplift/internal/config"
 )
 
@@ -11,19 +13,123 @@ var files = [4]string{".uplift.yml", ".uplift.yaml", "uplift.yml", "uplift.yaml"
 
 const (
 	currentWorkingDir = "."
+
+	// Environment variable containing the path to a config file
+	configEnv = "UPLIFT_CONFIG"
 )
 
-func loadConfig(dir string) (config.Uplift, error) {
-	for _, file := range files {
-		cfg, err := config.Load(filepath.Join(dir, file))
+func loadConfig(file, dir string) (config.Uplift, error) {
+	path, err := findConfig(file, dir)
+	if err != nil || path == "" {
+		return config.Uplift{}, err
+	}
+
+	data, err := resolveConfig(path)
+	if err != nil {
+		return config.Uplift{}, err
+	}
+
+	if err := enterConfigDir(path, file, dir); err != nil {
+		return config.Uplift{}, err
+	}
+
+	cfg, err := config.Parse(data)
+	if err != nil {
+		return cfg, err
+	}
+
+	return cfg, validatePipelines(cfg)
+}
+
+// resolveConfig returns the effective config of the config file found at path,
+// after merging any base configs it extends
+func resolveConfig(path string) ([]byte, error) {
+	log.WithField("path", path).Info("loaded config")
+	return config.Resolve(path)
+}
+
+// enterConfigDir changes the working directory to the directory of a config file
+// discovered within a parent of the current working directory. Any relative path
+// within the config, such as a file to bump, then resolves exactly as it would
+// when running from that directory
+func enterConfigDir(path, file, dir string) error {
+	if file != "" || os.Getenv(configEnv) != "" || dir != currentWorkingDir {
+		return nil
+	}
+
+	cfgDir := filepath.Dir(path)
+	if cfgDir == currentWorkingDir {
+		return nil
+	}
+
+	log.WithField("dir", cfgDir).Info("changing to directory of config")
+	return os.Chdir(cfgDir)
+}
+
+// findConfig identifies the config file to load. An explicit file takes precedence,
+// followed by the UPLIFT_CONFIG environment variable. Otherwise, the directory is
+// searched, followed by each of its parents up to the root of the repository, if
+// searching from the current working directory. An empty path is returned if no
+// config file exists
+func findConfig(file, dir string) (string, error) {
+	if file == "" {
+		file = os.Getenv(configEnv)
+	}
 
-		// If the file doesn't exist, try another, until the array is exhausted
-		if err != nil && os.IsNotExist(err) {
+	if file != "" {
+		if _, err := os.Stat(file); err != nil {
+			return "", fmt.Errorf("config file %s does not exist", file)
+		}
+		return file, nil
+	}
+
+	for _, d := range searchDirs(dir) {
+		var found []string
+		for _, f := range files {
+			path := filepath.Join(d, f)
+			if fi, err := os.Stat(path); err == nil && !fi.IsDir() {
+				found = append(found, path)
+			}
+		}
+
+		switch len(found) {
+		case 0:
 			continue
+		case 1:
+			return found[0], nil
+		default:
+			return "", fmt.Errorf("multiple config files found %v, only one is supported. Remove all but one, or select one using --config", found)
 		}
+	}
 
-		return cfg, err
+	log.Debug("no config file found, using defaults")
+	return "", nil
+}
+
+// searchDirs lists every directory to search for a config file, from the
+// current working directory up to the root of the repository. If not within
+// a repository, only the current working directory is searched
+func searchDirs(dir string) []string {
+	if dir != currentWorkingDir {
+		return []string{dir}
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return []string{dir}
 	}
 
-	return config.Uplift{}, nil
+	var dirs []string
+	for rel := dir; ; rel = filepath.Join(rel, "..") {
+		dirs = append(dirs, rel)
+		if _, err := os.Stat(filepath.Join(abs, ".git")); err == nil {
+			return dirs
+		}
+
+		parent := filepath.Dir(abs)
+		if parent == abs {
+			return []string{dir}
+		}
+		abs = parent
+	}
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"os"
 	"testing"
 
 	"github.com/purpleclay/gitz/gittest"
@@ -35,7 +36,7 @@ func TestLoadConfig(t *testing.T) {
 			gittest.InitRepository(t)
 			gittest.TempFile(t, tt.filename, "annotatedTags: true")
 
-			cfg, err := loadConfig(currentWorkingDir)
+			cfg, err := loadConfig("", currentWorkingDir)
 
 			require.NoError(t, err)
 			require.True(t, cfg.AnnotatedTags)
@@ -47,14 +48,14 @@ func TestLoadConfig_Malformed(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.TempFile(t, ".uplift.yml", "firstV")
 
-	_, err := loadConfig(currentWorkingDir)
+	_, err := loadConfig("", currentWorkingDir)
 	assert.Error(t, err)
 }
 
 func TestLoadConfig_NotExists(t *testing.T) {
 	gittest.InitRepository(t)
 
-	_, err := loadConfig(currentWorkingDir)
+	_, err := loadConfig("", currentWorkingDir)
 	assert.NoError(t, err)
 }
 
@@ -62,7 +63,80 @@ func TestLoadConfig_CustomLocation(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.TempFile(t, "custom/.uplift.yml", "annotatedTags: true")
 
-	cfg, err := loadConfig("custom")
+	cfg, err := loadConfig("", "custom")
 	assert.NoError(t, err)
 	require.True(t, cfg.AnnotatedTags)
 }
+
+func TestLoadConfig_DiscoversUpward(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", "annotatedTags: true")
+	require.NoError(t, os.MkdirAll("nested/dir", 0o755))
+	t.Chdir("nested/dir")
+
+	cfg, err := loadConfig("", currentWorkingDir)
+	require.NoError(t, err)
+	assert.True(t, cfg.AnnotatedTags)
+}
+
+func TestLoadConfig_DiscoveryStopsAtRepositoryRoot(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", "annotatedTags: true")
+
+	// A nested repository must never use the config of its parent
+	require.NoError(t, os.MkdirAll("nested/.git", 0o755))
+	t.Chdir("nested")
+
+	cfg, err := loadConfig("", currentWorkingDir)
+	require.NoError(t, err)
+	assert.False(t, cfg.AnnotatedTags)
+}
+
+func TestLoadConfig_MultipleCandidates(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", "annotatedTags: true")
+	gittest.TempFile(t, "uplift.yaml", "annotatedTags: false")
+
+	_, err := loadConfig("", currentWorkingDir)
+	require.EqualError(t, err, "multiple config files found [.uplift.yml uplift.yaml], only one is supported. Remove all but one, or select one using --config")
+}
+
+func TestLoadConfig_File(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", "annotatedTags: false")
+	gittest.TempFile(t, "uplift.yaml", "annotatedTags: false")
+	gittest.TempFile(t, "config/release.yml", "annotatedTags: true")
+
+	cfg, err := loadConfig("config/release.yml", currentWorkingDir)
+	require.NoError(t, err)
+	assert.True(t, cfg.AnnotatedTags)
+}
+
+func TestLoadConfig_FileFromEnv(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", "annotatedTags: false")
+	gittest.TempFile(t, "config/release.yml", "annotatedTags: true")
+	t.Setenv("UPLIFT_CONFIG", "config/release.yml")
+
+	cfg, err := loadConfig("", currentWorkingDir)
+	require.NoError(t, err)
+	assert.True(t, cfg.AnnotatedTags)
+}
+
+func TestLoadConfig_FileTakesPrecedenceOverEnv(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "env.yml", "annotatedTags: false")
+	gittest.TempFile(t, "flag.yml", "annotatedTags: true")
+	t.Setenv("UPLIFT_CONFIG", "env.yml")
+
+	cfg, err := loadConfig("flag.yml", currentWorkingDir)
+	require.NoError(t, err)
+	assert.True(t, cfg.AnnotatedTags)
+}
+
+func TestLoadConfig_FileNotExists(t *testing.T) {
+	gittest.InitRepository(t)
+
+	_, err := loadConfig("missing.yml", currentWorkingDir)
+	require.EqualError(t, err, "config file missing.yml does not exist")
+}
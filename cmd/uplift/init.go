@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/task/scm"
+	git "github.com/purpleclay/gitz"
+	"github.com/spf13/cobra"
+)
+
+const (
+	initLongDesc = `Generate a commented uplift configuration file for your repository. Uplift
+will detect any supported ecosystem files (package.json, Chart.yaml, Cargo.toml
+and go.mod) and configure them to be bumped. The SCM provider is detected from
+the origin remote of the repository, and any required settings are included.
+
+Once generated, review the configuration and adjust it to your needs. Run
+uplift check to validate any changes.`
+
+	initExamples = `
+# Generate a configuration file within the current directory
+uplift init
+
+# Replace an existing configuration file
+uplift init --force`
+
+	// The location of the published JSON schema for the uplift config
+	schemaURL = "https://upliftci.dev/static/schema.json"
+)
+
+type initOptions struct {
+	Force bool
+	*globalOptions
+}
+
+// ecosystem describes a file that identifies a language or package manager,
+// along with the config needed to bump its version
+type ecosystem struct {
+	File string
+	Bump string
+}
+
+// Every supported ecosystem, in the order they are written to the config
+var ecosystems = []ecosystem{
+	{
+		File: "package.json",
+		Bump: `  # Detected a package.json file
+  - file: package.json
+    json:
+      - path: "version"
+        semver: true
+`,
+	},
+	{
+		File: "Chart.yaml",
+		Bump: `  # Detected a Helm chart
+  - file: Chart.yaml
+    regex:
+      - pattern: "version: $VERSION"
+        semver: true
+        count: 1
+`,
+	},
+	{
+		File: "Cargo.toml",
+		Bump: `  # Detected a Rust crate
+  - file: Cargo.toml
+    regex:
+      - pattern: 'version = "$VERSION"'
+        semver: true
+        count: 1
+`,
+	},
+	{
+		File: "go.mod",
+	},
+}
+
+func newInitCmd(gopts *globalOptions, out io.Writer) *cobra.Command {
+	opts := initOptions{
+		globalOptions: gopts,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "init",
+		Short:   "Generate an uplift configuration file for your repository",
+		Long:    initLongDesc,
+		Example: initExamples,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return initConfig(opts, out)
+		},
+	}
+
+	f := cmd.Flags()
+	f.BoolVar(&opts.Force, "force", false, "replace any existing configuration file")
+
+	return cmd
+}
+
+func initConfig(opts initOptions, out io.Writer) error {
+	dir := opts.ConfigDir
+	if dir == "" {
+		dir = currentWorkingDir
+	}
+
+	if !opts.Force {
+		for _, f := range files {
+			path := filepath.Join(dir, f)
+			if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("config file %s already exists, use --force to replace it", path)
+			}
+		}
+	}
+
+	var found []ecosystem
+	for _, e := range ecosystems {
+		if _, err := os.Stat(filepath.Join(dir, e.File)); err == nil {
+			log.WithField("file", e.File).Info("detected ecosystem")
+			found = append(found, e)
+		}
+	}
+
+	cfg := generateConfig(found, detectRemote())
+
+	if opts.DryRun {
+		fmt.Fprint(out, cfg)
+		return nil
+	}
+
+	path := filepath.Join(dir, files[0])
+	if err := os.WriteFile(path, []byte(cfg), 0o644); err != nil {
+		return err
+	}
+
+	log.WithField("path", path).Info("generated config")
+	return nil
+}
+
+// detectRemote parses the origin remote of the current repository. An empty
+// remote is returned if it cannot be identified
+func detectRemote() scm.Remote {
+	gc, err := git.NewClient()
+	if err != nil {
+		log.Debug("not a git repository, skipping scm detection")
+		return scm.Remote{}
+	}
+
+	repo, err := gc.Repository()
+	if err != nil || repo.Origin == "" {
+		log.Debug("no origin remote found, skipping scm detection")
+		return scm.Remote{}
+	}
+
+	rem, err := scm.ParseRemote(repo.Origin)
+	if err != nil {
+		log.WithError(err).Debug("failed to parse origin remote")
+		return scm.Remote{}
+	}
+
+	log.WithField("host", rem.Host).Info("detected scm host")
+	return rem
+}
+
+func generateConfig(found []ecosystem, rem scm.Remote) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# yaml-language-server: $schema=%s\n", schemaURL)
+	b.WriteString(`#
+# Generated by uplift init. For a full list of options, see:
+# https://upliftci.dev/reference/config/
+
+# Uncomment to use a custom commit message when committing any bumped files
+# commitMessage: "chore(release): this is a custom release message"
+`)
+
+	var bumps []string
+	goModule := false
+	for _, e := range found {
+		if e.Bump == "" {
+			goModule = e.File == "go.mod"
+			continue
+		}
+		bumps = append(bumps, e.Bump)
+	}
+
+	if len(bumps) > 0 {
+		b.WriteString("\n# Files whose semantic version will be bumped with each release\nbumps:\n")
+		b.WriteString(strings.Join(bumps, "\n"))
+	}
+
+	if goModule {
+		b.WriteString(`
+# Detected a Go module. Go modules are versioned using git tags alone,
+# so no files need to be bumped
+`)
+	}
+
+	b.WriteString(`
+changelog:
+  # The order in which commits are listed within the changelog
+  sort: desc
+
+  # Exclude any commits made by uplift from the changelog
+  exclude:
+    - '^ci\(uplift\)'
+`)
+
+	b.WriteString(scmConfig(rem))
+	return b.String()
+}
+
+// scmConfig generates any config needed by the SCM provider hosting the remote.
+// Self-hosted providers are identified by their hostname
+func scmConfig(rem scm.Remote) string {
+	host := rem.Host
+	switch {
+	case host == "":
+		return `
+# No origin remote was detected. If your repository is hosted on a
+# self-hosted SCM, provide its URL to enable changelog links:
+# gitlab:
+#   url: https://gitlab.example.com
+`
+	case host == "github.com", host == "gitlab.com", strings.HasPrefix(host, "git-codecommit"):
+		return fmt.Sprintf(`
+# Detected %s as the SCM provider, no further config is needed
+`, host)
+	}
+
+	for _, provider := range []string{"gitlab", "gitea", "github"} {
+		if strings.Contains(host, provider) {
+			return fmt.Sprintf(`
+# Detected a self-hosted SCM provider from the origin remote
+%s:
+  url: https://%s
+`, provider, host)
+		}
+	}
+
+	return fmt.Sprintf(`
+# The SCM provider hosting %s could not be identified. If it is a
+# self-hosted GitHub, GitLab or Gitea instance, provide its URL:
+# gitea:
+#   url: https://%s
+`, host, host)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/task/scm"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "package.json", `{"version": "0.1.0"}`)
+	gittest.TempFile(t, "Chart.yaml", "version: 0.1.0")
+	gittest.TempFile(t, "Cargo.toml", `version = "0.1.0"`)
+	gittest.TempFile(t, "go.mod", "module example.com/test")
+
+	initCmd := newInitCmd(&globalOptions{ConfigDir: "."}, os.Stdout)
+	err := initCmd.Execute()
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(".uplift.yml")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# yaml-language-server: $schema=https://upliftci.dev/static/schema.json")
+	assert.Contains(t, string(data), "# Detected a Go module")
+
+	cfg, err := config.Parse(data)
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+
+	require.Len(t, cfg.Bumps, 3)
+	assert.Equal(t, "package.json", cfg.Bumps[0].File)
+	assert.Equal(t, "Chart.yaml", cfg.Bumps[1].File)
+	assert.Equal(t, "Cargo.toml", cfg.Bumps[2].File)
+	assert.Equal(t, []string{`^ci\(uplift\)`}, cfg.Changelog.Exclude)
+}
+
+func TestInit_NoEcosystem(t *testing.T) {
+	gittest.InitRepository(t)
+
+	initCmd := newInitCmd(&globalOptions{ConfigDir: "."}, os.Stdout)
+	err := initCmd.Execute()
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(".uplift.yml")
+	require.NoError(t, err)
+
+	cfg, err := config.Parse(data)
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+	assert.Empty(t, cfg.Bumps)
+}
+
+func TestInit_ExistingConfig(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "uplift.yml", "annotatedTags: true")
+
+	initCmd := newInitCmd(&globalOptions{ConfigDir: "."}, os.Stdout)
+	err := initCmd.Execute()
+
+	require.EqualError(t, err, "config file uplift.yml already exists, use --force to replace it")
+}
+
+func TestInit_Force(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", "annotatedTags: true")
+
+	initCmd := newInitCmd(&globalOptions{ConfigDir: "."}, os.Stdout)
+	initCmd.SetArgs([]string{"--force"})
+	err := initCmd.Execute()
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(".uplift.yml")
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "annotatedTags")
+}
+
+func TestInit_DryRun(t *testing.T) {
+	gittest.InitRepository(t)
+
+	var buf bytes.Buffer
+	initCmd := newInitCmd(&globalOptions{ConfigDir: ".", DryRun: true}, &buf)
+	err := initCmd.Execute()
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "changelog:")
+	assert.NoFileExists(t, ".uplift.yml")
+}
+
+func TestScmConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     string
+		expected string
+	}{
+		{
+			name:     "GitHub",
+			host:     "github.com",
+			expected: "# Detected github.com as the SCM provider",
+		},
+		{
+			name:     "GitLab",
+			host:     "gitlab.com",
+			expected: "# Detected gitlab.com as the SCM provider",
+		},
+		{
+			name:     "SelfHostedGitLab",
+			host:     "gitlab.example.com",
+			expected: "gitlab:\n  url: https://gitlab.example.com",
+		},
+		{
+			name:     "SelfHostedGitea",
+			host:     "gitea.example.com",
+			expected: "gitea:\n  url: https://gitea.example.com",
+		},
+		{
+			name:     "GitHubEnterprise",
+			host:     "github.example.com",
+			expected: "github:\n  url: https://github.example.com",
+		},
+		{
+			name:     "Unrecognised",
+			host:     "git.example.com",
+			expected: "# The SCM provider hosting git.example.com could not be identified",
+		},
+		{
+			name:     "NoRemote",
+			host:     "",
+			expected: "# No origin remote was detected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := generateConfig(nil, scm.Remote{Host: tt.host})
+			assert.Contains(t, cfg, tt.expected)
+
+			parsed, err := config.Parse([]byte(cfg))
+			require.NoError(t, err)
+			require.NoError(t, parsed.Validate())
+		})
+	}
+}
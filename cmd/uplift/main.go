@@ -8,7 +8,10 @@ import (
 func main() {
 	rootCmd := newRootCmd(os.Stdout)
 
-	if err := rootCmd.Cmd.Execute(); err != nil {
+	err := rootCmd.Cmd.Execute()
+	rootCmd.Close()
+
+	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
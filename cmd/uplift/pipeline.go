@@ -0,0 +1,245 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/task"
+	"github.com/gembaadvantage/uplift/internal/task/bump"
+	"github.com/gembaadvantage/uplift/internal/task/changelog"
+	"github.com/gembaadvantage/uplift/internal/task/fetchtag"
+	"github.com/gembaadvantage/uplift/internal/task/finalize"
+	"github.com/gembaadvantage/uplift/internal/task/gitcheck"
+	"github.com/gembaadvantage/uplift/internal/task/gitcommit"
+	"github.com/gembaadvantage/uplift/internal/task/gitpush"
+	"github.com/gembaadvantage/uplift/internal/task/gittag"
+	"github.com/gembaadvantage/uplift/internal/task/gpgimport"
+	"github.com/gembaadvantage/uplift/internal/task/hook/after"
+	"github.com/gembaadvantage/uplift/internal/task/hook/afterbump"
+	"github.com/gembaadvantage/uplift/internal/task/hook/afterchangelog"
+	"github.com/gembaadvantage/uplift/internal/task/hook/aftertag"
+	"github.com/gembaadvantage/uplift/internal/task/hook/before"
+	"github.com/gembaadvantage/uplift/internal/task/hook/beforebump"
+	"github.com/gembaadvantage/uplift/internal/task/hook/beforechangelog"
+	"github.com/gembaadvantage/uplift/internal/task/hook/beforetag"
+	"github.com/gembaadvantage/uplift/internal/task/nextcommit"
+	"github.com/gembaadvantage/uplift/internal/task/nextsemver"
+	"github.com/gembaadvantage/uplift/internal/task/openpr"
+	"github.com/gembaadvantage/uplift/internal/task/plugin"
+	"github.com/gembaadvantage/uplift/internal/task/releasebranch"
+	"github.com/gembaadvantage/uplift/internal/task/scm"
+	"github.com/gembaadvantage/uplift/internal/task/sshimport"
+	"github.com/gembaadvantage/uplift/internal/task/summary"
+	"github.com/gembaadvantage/uplift/internal/task/verifysig"
+)
+
+// A step prefixed with plugin: will execute a single plugin by name
+const pluginStep = "plugin:"
+
+// Every known task, keyed by the name used to reference it within a pipeline
+var steps = map[string]task.Runner{
+	"after":                   after.Task{},
+	"afterbump":               afterbump.Task{},
+	"afterchangelog":          afterchangelog.Task{},
+	"aftertag":                aftertag.Task{},
+	"before":                  before.Task{},
+	"beforebump":              beforebump.Task{},
+	"beforechangelog":         beforechangelog.Task{},
+	"beforetag":               beforetag.Task{},
+	"bump":                    bump.Task{},
+	"changelog":               changelog.Task{},
+	"fetchtag":                fetchtag.Task{},
+	"finalize":                finalize.Task{},
+	"gitcheck":                gitcheck.Task{},
+	"gitcommit":               gitcommit.Task{},
+	"gitpush":                 gitpush.Task{},
+	"gittag":                  gittag.Task{},
+	"gpgimport":               gpgimport.Task{},
+	"nextcommit":              nextcommit.Task{},
+	"nextsemver":              nextsemver.Task{},
+	"openpr":                  openpr.Task{},
+	"releasebranch":           releasebranch.Task{},
+	"scm":                     scm.Task{},
+	"sshimport":               sshimport.Task{},
+	"summary":                 summary.Task{},
+	"verifysig":               verifysig.Task{},
+	"plugins:after":           plugin.Task{Stage: plugin.StageAfter},
+	"plugins:afterBump":       plugin.Task{Stage: plugin.StageAfterBump},
+	"plugins:afterChangelog":  plugin.Task{Stage: plugin.StageAfterChangelog},
+	"plugins:afterTag":        plugin.Task{Stage: plugin.StageAfterTag},
+	"plugins:before":          plugin.Task{Stage: plugin.StageBefore},
+	"plugins:beforeBump":      plugin.Task{Stage: plugin.StageBeforeBump},
+	"plugins:beforeChangelog": plugin.Task{Stage: plugin.StageBeforeChangelog},
+	"plugins:beforeTag":       plugin.Task{Stage: plugin.StageBeforeTag},
+}
+
+// Every known task that modifies the repository
+var modifyingSteps = []string{"bump", "finalize", "gitcommit", "gitpush", "gittag", "openpr", "releasebranch"}
+
+func lookupStep(name string) (task.Runner, bool) {
+	if p, ok := strings.CutPrefix(name, pluginStep); ok && p != "" {
+		return plugin.Task{Name: p}, true
+	}
+
+	t, ok := steps[name]
+	return t, ok
+}
+
+func stepName(t task.Runner) string {
+	if p, ok := t.(plugin.Task); ok && p.Name != "" {
+		return pluginStep + p.Name
+	}
+
+	for name, s := range steps {
+		if s == t {
+			return name
+		}
+	}
+	return ""
+}
+
+func pipelineConfig(cfg config.Uplift, cmd string) *config.Pipeline {
+	if cfg.Pipelines == nil {
+		return nil
+	}
+
+	switch cmd {
+	case "bump":
+		return cfg.Pipelines.Bump
+	case "changelog":
+		return cfg.Pipelines.Changelog
+	case "changelogDiff":
+		return cfg.Pipelines.ChangelogDiff
+	case "release":
+		return cfg.Pipelines.Release
+	case "tag":
+		return cfg.Pipelines.Tag
+	}
+	return nil
+}
+
+// validatePipelines ensures every step referenced within the pipelines config
+// is a known task, or a configured plugin. A plugin with a stage cannot also be
+// placed by name, as it would be executed twice. A diff of the changelog must
+// never include a step that modifies the repository
+func validatePipelines(cfg config.Uplift) error {
+	var errs []error
+	for _, cmd := range []string{"bump", "changelog", "changelogDiff", "release", "tag"} {
+		p := pipelineConfig(cfg, cmd)
+		if p == nil {
+			continue
+		}
+
+		names := append(slices.Clone(p.Steps), p.Disable...)
+		for _, ins := range p.Insert {
+			names = append(names, ins.Step, ins.After, ins.Before)
+		}
+
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+
+			if err := validateStep(cfg, name); err != nil {
+				errs = append(errs, fmt.Errorf("pipelines.%s: %w", cmd, err))
+			}
+		}
+
+		placed := slices.Clone(p.Steps)
+		for _, ins := range p.Insert {
+			placed = append(placed, ins.Step)
+		}
+
+		for _, name := range placed {
+			if err := validatePlacedPlugin(cfg, name); err != nil {
+				errs = append(errs, fmt.Errorf("pipelines.%s: %w", cmd, err))
+			}
+
+			if cmd == "changelogDiff" && slices.Contains(modifyingSteps, name) {
+				errs = append(errs, fmt.Errorf("pipelines.%s: step '%s' modifies the repository and cannot be used when only outputting a diff",
+					cmd, name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validatePlacedPlugin(cfg config.Uplift, name string) error {
+	p, ok := strings.CutPrefix(name, pluginStep)
+	if !ok {
+		return nil
+	}
+
+	i := slices.IndexFunc(cfg.Plugins, func(cp config.Plugin) bool { return cp.Name == p })
+	if i == -1 || cfg.Plugins[i].Stage == "" {
+		return nil
+	}
+
+	return fmt.Errorf("step '%s' references a plugin with stage '%s', remove its stage to place it by name",
+		name, cfg.Plugins[i].Stage)
+}
+
+func validateStep(cfg config.Uplift, name string) error {
+	if _, ok := lookupStep(name); !ok {
+		return fmt.Errorf("unknown step '%s'", name)
+	}
+
+	if p, ok := strings.CutPrefix(name, pluginStep); ok {
+		if !slices.ContainsFunc(cfg.Plugins, func(cp config.Plugin) bool { return cp.Name == p }) {
+			return fmt.Errorf("step '%s' references a plugin that has not been configured", name)
+		}
+	}
+
+	return nil
+}
+
+// composePipeline customises the default tasks of a command using any pipeline
+// defined within the config. Steps replace the default tasks, before any steps
+// are disabled or inserted
+func composePipeline(cfg config.Uplift, cmd string, defaults []task.Runner) ([]task.Runner, error) {
+	p := pipelineConfig(cfg, cmd)
+	if p == nil {
+		return defaults, nil
+	}
+
+	tasks := slices.Clone(defaults)
+	if len(p.Steps) > 0 {
+		tasks = make([]task.Runner, 0, len(p.Steps))
+		for _, name := range p.Steps {
+			t, ok := lookupStep(name)
+			if !ok {
+				return nil, fmt.Errorf("pipelines.%s: unknown step '%s'", cmd, name)
+			}
+			tasks = append(tasks, t)
+		}
+	}
+
+	tasks = slices.DeleteFunc(tasks, func(t task.Runner) bool {
+		return slices.Contains(p.Disable, stepName(t))
+	})
+
+	for _, ins := range p.Insert {
+		t, ok := lookupStep(ins.Step)
+		if !ok {
+			return nil, fmt.Errorf("pipelines.%s: unknown step '%s'", cmd, ins.Step)
+		}
+
+		anchor, offset := ins.After, 1
+		if ins.Before != "" {
+			anchor, offset = ins.Before, 0
+		}
+
+		i := slices.IndexFunc(tasks, func(t task.Runner) bool { return stepName(t) == anchor })
+		if i == -1 {
+			return nil, fmt.Errorf("pipelines.%s: cannot insert step '%s' relative to '%s', as it is not part of the pipeline",
+				cmd, ins.Step, anchor)
+		}
+		tasks = slices.Insert(tasks, i+offset, t)
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/task"
+	"github.com/gembaadvantage/uplift/internal/task/bump"
+	"github.com/gembaadvantage/uplift/internal/task/changelog"
+	"github.com/gembaadvantage/uplift/internal/task/gitcheck"
+	"github.com/gembaadvantage/uplift/internal/task/nextsemver"
+	"github.com/gembaadvantage/uplift/internal/task/plugin"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSteps_NamesEveryDefaultTask(t *testing.T) {
+	pipelines := [][]task.Runner{
+		releasePipeline,
+		pullRequestPipeline,
+		finalizePipeline,
+		tagRepoPipeline,
+		printNextTagPipeline,
+	}
+
+	for _, p := range pipelines {
+		for _, tk := range p {
+			assert.NotEmpty(t, stepName(tk), "task '%s' has no step name", tk.String())
+		}
+	}
+}
+
+func TestComposePipeline_NoConfig(t *testing.T) {
+	defaults := []task.Runner{gitcheck.Task{}, nextsemver.Task{}}
+
+	tasks, err := composePipeline(config.Uplift{}, "release", defaults)
+	require.NoError(t, err)
+	assert.Equal(t, defaults, tasks)
+}
+
+func TestComposePipeline_Steps(t *testing.T) {
+	cfg := config.Uplift{
+		Pipelines: &config.Pipelines{
+			Release: &config.Pipeline{
+				Steps: []string{"nextsemver", "changelog", "bump"},
+			},
+		},
+	}
+
+	tasks, err := composePipeline(cfg, "release", []task.Runner{gitcheck.Task{}, nextsemver.Task{}, bump.Task{}, changelog.Task{}})
+	require.NoError(t, err)
+	assert.Equal(t, []task.Runner{nextsemver.Task{}, changelog.Task{}, bump.Task{}}, tasks)
+}
+
+func TestComposePipeline_Disable(t *testing.T) {
+	cfg := config.Uplift{
+		Pipelines: &config.Pipelines{
+			Tag: &config.Pipeline{
+				Disable: []string{"gitcheck"},
+			},
+		},
+	}
+
+	tasks, err := composePipeline(cfg, "tag", []task.Runner{gitcheck.Task{}, nextsemver.Task{}})
+	require.NoError(t, err)
+	assert.Equal(t, []task.Runner{nextsemver.Task{}}, tasks)
+}
+
+func TestComposePipeline_Insert(t *testing.T) {
+	cfg := config.Uplift{
+		Pipelines: &config.Pipelines{
+			Bump: &config.Pipeline{
+				Insert: []config.PipelineInsert{
+					{Step: "plugin:publish", After: "nextsemver"},
+					{Step: "changelog", Before: "bump"},
+				},
+			},
+		},
+	}
+
+	tasks, err := composePipeline(cfg, "bump", []task.Runner{nextsemver.Task{}, bump.Task{}})
+	require.NoError(t, err)
+	assert.Equal(t, []task.Runner{
+		nextsemver.Task{},
+		plugin.Task{Name: "publish"},
+		changelog.Task{},
+		bump.Task{},
+	}, tasks)
+}
+
+func TestComposePipeline_InsertMissingAnchor(t *testing.T) {
+	cfg := config.Uplift{
+		Pipelines: &config.Pipelines{
+			Changelog: &config.Pipeline{
+				Insert: []config.PipelineInsert{{Step: "plugin:publish", After: "gittag"}},
+			},
+		},
+	}
+
+	_, err := composePipeline(cfg, "changelog", []task.Runner{changelog.Task{}})
+	require.EqualError(t, err, "pipelines.changelog: cannot insert step 'plugin:publish' relative to 'gittag', as it is not part of the pipeline")
+}
+
+func TestValidatePipelines(t *testing.T) {
+	cfg := config.Uplift{
+		Pipelines: &config.Pipelines{
+			Release: &config.Pipeline{
+				Steps:   []string{"gitchek", "plugin:publish"},
+				Disable: []string{"bump"},
+				Insert:  []config.PipelineInsert{{Step: "plugin:notify", After: "nextsemvr"}},
+			},
+		},
+		Plugins: []config.Plugin{{Name: "notify", Cmd: "notify"}},
+	}
+
+	err := validatePipelines(cfg)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "pipelines.release: unknown step 'gitchek'")
+	assert.ErrorContains(t, err, "pipelines.release: step 'plugin:publish' references a plugin that has not been configured")
+	assert.ErrorContains(t, err, "pipelines.release: unknown step 'nextsemvr'")
+	assert.NotContains(t, err.Error(), "'bump'")
+	assert.NotContains(t, err.Error(), "plugin:notify")
+}
+
+func TestValidatePipelines_PluginWithStagePlacedByName(t *testing.T) {
+	cfg := config.Uplift{
+		Pipelines: &config.Pipelines{
+			Tag: &config.Pipeline{
+				Insert: []config.PipelineInsert{{Step: "plugin:notify", After: "gittag"}},
+			},
+			Release: &config.Pipeline{
+				Steps:   []string{"nextsemver", "plugin:notify", "plugin:publish"},
+				Disable: []string{"plugin:notify"},
+			},
+		},
+		Plugins: []config.Plugin{
+			{Name: "notify", Cmd: "notify", Stage: "afterTag"},
+			{Name: "publish", Cmd: "publish"},
+		},
+	}
+
+	err := validatePipelines(cfg)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "pipelines.release: step 'plugin:notify' references a plugin with stage 'afterTag', remove its stage to place it by name")
+	assert.ErrorContains(t, err, "pipelines.tag: step 'plugin:notify' references a plugin with stage 'afterTag', remove its stage to place it by name")
+	assert.NotContains(t, err.Error(), "plugin:publish")
+	assert.Len(t, strings.Split(err.Error(), "\n"), 2)
+}
+
+func TestValidatePipelines_ChangelogDiffModifiesRepository(t *testing.T) {
+	cfg := config.Uplift{
+		Pipelines: &config.Pipelines{
+			Changelog: &config.Pipeline{
+				Steps: []string{"changelog", "gitcommit"},
+			},
+			ChangelogDiff: &config.Pipeline{
+				Steps:  []string{"changelog", "gitcommit"},
+				Insert: []config.PipelineInsert{{Step: "gitpush", After: "changelog"}},
+			},
+		},
+	}
+
+	err := validatePipelines(cfg)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "pipelines.changelogDiff: step 'gitcommit' modifies the repository and cannot be used when only outputting a diff")
+	assert.ErrorContains(t, err, "pipelines.changelogDiff: step 'gitpush' modifies the repository and cannot be used when only outputting a diff")
+	assert.NotContains(t, err.Error(), "pipelines.changelog:")
+}
+
+func TestLoadConfig_InvalidPipeline(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, ".uplift.yml", `pipelines:
+  tag:
+    disable: [unknown]`)
+
+	_, err := loadConfig("", currentWorkingDir)
+	require.EqualError(t, err, "pipelines.tag: unknown step 'unknown'")
+}
+
+func TestTag_DisableStep(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: new feature"), gittest.WithFiles("dirty.txt"))
+	gittest.TempFile(t, ".uplift.yml", `pipelines:
+  tag:
+    disable: [gitcheck]`)
+
+	tagCmd := newTagCmd(noChangesPushed(), os.Stdout)
+
+	err := tagCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	tags := gittest.Tags(t)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "v0.1.0", tags[0])
+}
+
+func TestChangelog_DiffOnlyIgnoresChangelogPipeline(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("(tag: 0.1.0) feature"),
+		gittest.WithCommittedFiles(".uplift.yml"),
+		gittest.WithFileContent(".uplift.yml", `hooks:
+  beforeChangelog:
+    - touch before-changelog.out
+pipelines:
+  changelog:
+    steps: [beforechangelog, changelog, gitcommit]`))
+
+	var buf bytes.Buffer
+	chglogCmd := newChangelogCmd(noChangesPushed(), &buf)
+	chglogCmd.Cmd.SetArgs([]string{"--diff-only"})
+
+	err := chglogCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "## 0.1.0")
+	assert.NoFileExists(t, "before-changelog.out")
+}
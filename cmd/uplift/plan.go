@@ -0,0 +1,119 @@
+package main
+
+import (
+	"io"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/task"
+	"github.com/gembaadvantage/uplift/internal/task/bump"
+	"github.com/gembaadvantage/uplift/internal/task/changelog"
+	"github.com/gembaadvantage/uplift/internal/task/fetchtag"
+	"github.com/gembaadvantage/uplift/internal/task/gitcheck"
+	"github.com/gembaadvantage/uplift/internal/task/gittag"
+	"github.com/gembaadvantage/uplift/internal/task/nextcommit"
+	"github.com/gembaadvantage/uplift/internal/task/nextsemver"
+	"github.com/gembaadvantage/uplift/internal/task/plan"
+	"github.com/gembaadvantage/uplift/internal/task/scm"
+	"github.com/gembaadvantage/uplift/internal/task/verifysig"
+	"github.com/spf13/cobra"
+)
+
+const (
+	planLongDesc = `Plan the next semantic release of your git repository without making any
+changes. Uplift will run through its release process in a no-write mode and
+print a consolidated report of everything that would happen. This includes
+the current and next semantic versions, the commits driving the increment, a
+unified diff of every file that would be bumped, the changelog entry, along
+with the commit message and tag that would be created.
+
+The same report can be written as markdown, ready to be posted as a comment
+on a pull request by your CI.`
+
+	planExamples = `
+# Plan the next semantic release
+uplift plan
+
+# Plan the next semantic release and write the report as markdown
+uplift plan --markdown uplift-plan.md
+
+# Plan the next semantic release with a prerelease suffix
+uplift plan --prerelease beta.1`
+)
+
+type planOptions struct {
+	Markdown string
+	releaseOptions
+}
+
+type planCommand struct {
+	Cmd  *cobra.Command
+	Opts planOptions
+}
+
+func newPlanCmd(gopts *globalOptions, out io.Writer) *planCommand {
+	planCmd := &planCommand{
+		Opts: planOptions{
+			releaseOptions: releaseOptions{
+				globalOptions: gopts,
+			},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:     "plan",
+		Short:   "Preview the next semantic release of a repository",
+		Long:    planLongDesc,
+		Example: planExamples,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return planRelease(planCmd.Opts, out)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&planCmd.Opts.Markdown, "markdown", "", "write the release plan as markdown to the provided file")
+	f.BoolVar(&planCmd.Opts.FetchTags, "fetch-all", false, "fetch all tags from the remote repository")
+	f.StringVar(&planCmd.Opts.Prerelease, "prerelease", "", "append a prerelease suffix to next calculated semantic version")
+	f.BoolVar(&planCmd.Opts.SkipChangelog, "skip-changelog", false, "skips the creation or amendment of a changelog")
+	f.BoolVar(&planCmd.Opts.SkipBumps, "skip-bumps", false, "skips the bumping of any files")
+	f.BoolVar(&planCmd.Opts.NoPrefix, "no-prefix", false, "strip the default 'v' prefix from the next calculated semantic version")
+	f.StringSliceVar(&planCmd.Opts.Exclude, "exclude", []string{}, "a list of regexes for excluding conventional commits from the changelog")
+	f.StringSliceVar(&planCmd.Opts.Include, "include", []string{}, "a list of regexes to cherry-pick conventional commits for the changelog")
+	f.StringVar(&planCmd.Opts.Sort, "sort", "", "the sort order of commits within each changelog entry")
+	f.BoolVar(&planCmd.Opts.Multiline, "multiline", false, "include multiline commit messages within changelog (skips truncation)")
+	f.BoolVar(&planCmd.Opts.SkipPrerelease, "skip-changelog-prerelease", false, "skips the creation of a changelog entry for a prerelease")
+	f.BoolVar(&planCmd.Opts.TrimHeader, "trim-header", false, "strip any lines preceding the conventional commit type in the commit message")
+
+	planCmd.Cmd = cmd
+	return planCmd
+}
+
+func planRelease(opts planOptions, out io.Writer) error {
+	ctx, err := setupReleaseContext(opts.releaseOptions, out)
+	if err != nil {
+		return err
+	}
+
+	// A plan must never write any changes to the repository
+	ctx.DryRun = true
+	ctx.NoStage = true
+	ctx.NoPush = true
+	ctx.Plan = &context.Plan{
+		Markdown: opts.Markdown,
+	}
+
+	tasks := []task.Runner{
+		gitcheck.Task{},
+		scm.Task{},
+		fetchtag.Task{},
+		nextsemver.Task{},
+		verifysig.Task{},
+		nextcommit.Task{},
+		bump.Task{},
+		changelog.Task{},
+		gittag.Task{},
+		plan.Task{},
+	}
+
+	return task.Execute(ctx, tasks)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan(t *testing.T) {
+	log := `feat: new feature
+fix: bug fix
+docs: update docs`
+	gittest.InitRepository(t,
+		gittest.WithLog(log),
+		gittest.WithCommittedFiles("test.txt", ".uplift.yml"),
+		gittest.WithFileContent("test.txt", bumpFile, ".uplift.yml", bumpConfig))
+
+	var buf bytes.Buffer
+	planCmd := newPlanCmd(&globalOptions{}, &buf)
+	planCmd.Cmd.SetArgs([]string{"--markdown", "plan.md"})
+
+	err := planCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "Version:    none -> v0.1.0 (Minor)")
+	assert.Contains(t, out, "Tag:        v0.1.0 (lightweight)")
+	assert.Contains(t, out, "Commit:     ci(uplift): uplifted for version v0.1.0")
+	assert.Contains(t, out, "-version: 0.0.0\n-appVersion: 0.0.0\n+version: v0.1.0\n+appVersion: v0.1.0")
+	assert.Contains(t, out, "## v0.1.0")
+	assert.FileExists(t, "plan.md")
+
+	// Ensure nothing has been written to the repository
+	assert.Empty(t, gittest.Tags(t))
+	assert.False(t, changelogExists(t))
+
+	actual, err := os.ReadFile("test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, bumpFile, string(actual))
+}
+
+func TestPlan_NoRelease(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("(tag: v0.1.0) docs: update docs"))
+
+	var buf bytes.Buffer
+	planCmd := newPlanCmd(&globalOptions{}, &buf)
+
+	err := planCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "No release detected, version remains at v0.1.0")
+}
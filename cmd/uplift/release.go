@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/apex/log"
 	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/journal"
 	"github.com/gembaadvantage/uplift/internal/semver"
 	"github.com/gembaadvantage/uplift/internal/task"
 	"github.com/gembaadvantage/uplift/internal/task/bump"
 	"github.com/gembaadvantage/uplift/internal/task/changelog"
 	"github.com/gembaadvantage/uplift/internal/task/fetchtag"
+	"github.com/gembaadvantage/uplift/internal/task/finalize"
 	"github.com/gembaadvantage/uplift/internal/task/gitcheck"
 	"github.com/gembaadvantage/uplift/internal/task/gitcommit"
+	"github.com/gembaadvantage/uplift/internal/task/gitpush"
 	"github.com/gembaadvantage/uplift/internal/task/gittag"
 	"github.com/gembaadvantage/uplift/internal/task/gpgimport"
 	"github.com/gembaadvantage/uplift/internal/task/hook/after"
@@ -25,7 +29,13 @@ import (
 	"github.com/gembaadvantage/uplift/internal/task/hook/beforetag"
 	"github.com/gembaadvantage/uplift/internal/task/nextcommit"
 	"github.com/gembaadvantage/uplift/internal/task/nextsemver"
+	"github.com/gembaadvantage/uplift/internal/task/openpr"
+	"github.com/gembaadvantage/uplift/internal/task/plugin"
+	"github.com/gembaadvantage/uplift/internal/task/releasebranch"
 	"github.com/gembaadvantage/uplift/internal/task/scm"
+	"github.com/gembaadvantage/uplift/internal/task/sshimport"
+	"github.com/gembaadvantage/uplift/internal/task/summary"
+	"github.com/gembaadvantage/uplift/internal/task/verifysig"
 	"github.com/spf13/cobra"
 )
 
@@ -38,6 +48,15 @@ repository. Uplift automatically handles the staging and pushing of modified
 files and the tagging of the repository with two separate git pushes. But this
 behavior can be disabled to manage these actions manually.
 
+Releases can also be published through a pull request, for repositories with a
+protected branch. By setting release.mode to pull-request, Uplift will commit all
+changes to a dedicated release branch and open a pull request. Once merged, use
+the '--finalize' flag to tag the merge commit.
+
+If the release fails, any changes made locally, such as bumped files, the
+changelog, the release commit and tag, are rolled back in reverse order. Changes
+that have already been pushed to the remote cannot be rolled back.
+
 Parts of this release process can be disabled if needed.
 
 https://upliftci.dev/first-release/`
@@ -57,7 +76,10 @@ uplift release --prerelease beta.1
 
 # Ensure any "v" prefix is stripped from the next calculated semantic
 # version to explicitly adhere to the SemVer specification
-uplift release --no-prefix`
+uplift release --no-prefix
+
+# Tag the merge commit of a release published through a pull request
+uplift release --finalize`
 )
 
 type releaseOptions struct {
@@ -73,6 +95,8 @@ type releaseOptions struct {
 	Multiline      bool
 	SkipPrerelease bool
 	TrimHeader     bool
+	ShowDiff       bool
+	Finalize       bool
 	*globalOptions
 }
 
@@ -117,43 +141,134 @@ func newReleaseCmd(gopts *globalOptions, out io.Writer) *releaseCommand {
 	f.BoolVar(&relCmd.Opts.Multiline, "multiline", false, "include multiline commit messages within changelog (skips truncation)")
 	f.BoolVar(&relCmd.Opts.SkipPrerelease, "skip-changelog-prerelease", false, "skips the creation of a changelog entry for a prerelease")
 	f.BoolVar(&relCmd.Opts.TrimHeader, "trim-header", false, "strip any lines preceding the conventional commit type in the commit message")
+	f.BoolVar(&relCmd.Opts.ShowDiff, "diff", false, "show a unified diff of all bumped files")
+	f.BoolVar(&relCmd.Opts.Finalize, "finalize", false, "tag the merge commit of a release published through a pull request")
 
 	relCmd.Cmd = cmd
 	return relCmd
 }
 
-func release(opts releaseOptions, out io.Writer) error {
-	ctx, err := setupReleaseContext(opts, out)
-	if err != nil {
-		return err
+var (
+	releasePipeline = []task.Runner{
+		gitcheck.Task{},
+		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
+		gpgimport.Task{},
+		sshimport.Task{},
+		scm.Task{},
+		fetchtag.Task{},
+		nextsemver.Task{},
+		verifysig.Task{},
+		nextcommit.Task{},
+		beforebump.Task{},
+		plugin.Task{Stage: plugin.StageBeforeBump},
+		bump.Task{},
+		afterbump.Task{},
+		plugin.Task{Stage: plugin.StageAfterBump},
+		beforechangelog.Task{},
+		plugin.Task{Stage: plugin.StageBeforeChangelog},
+		changelog.Task{},
+		afterchangelog.Task{},
+		plugin.Task{Stage: plugin.StageAfterChangelog},
+		gitcommit.Task{},
+		beforetag.Task{},
+		plugin.Task{Stage: plugin.StageBeforeTag},
+		gittag.Task{},
+		gitpush.Task{},
+		aftertag.Task{},
+		plugin.Task{Stage: plugin.StageAfterTag},
+		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
+		summary.Task{},
 	}
 
-	tasks := []task.Runner{
+	pullRequestPipeline = []task.Runner{
 		gitcheck.Task{},
 		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
 		gpgimport.Task{},
+		sshimport.Task{},
 		scm.Task{},
 		fetchtag.Task{},
 		nextsemver.Task{},
+		verifysig.Task{},
 		nextcommit.Task{},
 		beforebump.Task{},
+		plugin.Task{Stage: plugin.StageBeforeBump},
 		bump.Task{},
 		afterbump.Task{},
+		plugin.Task{Stage: plugin.StageAfterBump},
 		beforechangelog.Task{},
+		plugin.Task{Stage: plugin.StageBeforeChangelog},
 		changelog.Task{},
 		afterchangelog.Task{},
+		plugin.Task{Stage: plugin.StageAfterChangelog},
+		releasebranch.Task{},
 		gitcommit.Task{},
+		gitpush.Task{},
+		openpr.Task{},
+		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
+		summary.Task{},
+	}
+
+	finalizePipeline = []task.Runner{
+		gitcheck.Task{},
+		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
+		gpgimport.Task{},
+		sshimport.Task{},
+		scm.Task{},
+		fetchtag.Task{},
+		nextsemver.Task{},
+		verifysig.Task{},
+		nextcommit.Task{},
+		finalize.Task{},
 		beforetag.Task{},
+		plugin.Task{Stage: plugin.StageBeforeTag},
 		gittag.Task{},
+		gitpush.Task{},
 		aftertag.Task{},
+		plugin.Task{Stage: plugin.StageAfterTag},
 		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
+		summary.Task{},
 	}
+)
 
-	return task.Execute(ctx, tasks)
+func release(opts releaseOptions, out io.Writer) error {
+	ctx, err := setupReleaseContext(opts, out)
+	if err != nil {
+		return err
+	}
+
+	tasks := releasePipeline
+	if opts.Finalize {
+		if ctx.PullRequest == nil {
+			return errors.New("a release can only be finalized when release.mode is set to pull-request")
+		}
+		tasks = finalizePipeline
+	} else if ctx.PullRequest != nil {
+		tasks = pullRequestPipeline
+	}
+
+	if tasks, err = composePipeline(ctx.Config, "release", tasks); err != nil {
+		return err
+	}
+
+	if err := task.ExecuteWithHooks(ctx, tasks); err != nil {
+		log.Warn("release failed, rolling back any changes")
+		if rbErr := ctx.Journal.Rollback(ctx.GitClient); rbErr != nil {
+			log.WithError(rbErr).Error("release could not be fully rolled back")
+		}
+		return err
+	}
+
+	return nil
 }
 
 func setupReleaseContext(opts releaseOptions, out io.Writer) (*context.Context, error) {
-	cfg, err := loadConfig(opts.ConfigDir)
+	cfg, err := loadConfig(opts.Config, opts.ConfigDir)
 	if err != nil {
 		fmt.Printf("failed to load uplift config. %v", err)
 		return nil, err
@@ -162,6 +277,8 @@ func setupReleaseContext(opts releaseOptions, out io.Writer) (*context.Context,
 
 	// Set all values within the context
 	ctx.Debug = opts.Debug
+	ctx.TraceFile = opts.TraceFile
+	ctx.TraceEndpoint = opts.TraceEndpoint
 	ctx.DryRun = opts.DryRun
 	ctx.NoPush = opts.NoPush
 	ctx.NoStage = opts.NoStage
@@ -170,6 +287,10 @@ func setupReleaseContext(opts releaseOptions, out io.Writer) (*context.Context,
 	ctx.SkipChangelog = opts.SkipChangelog
 	ctx.SkipBumps = opts.SkipBumps
 	ctx.NoPrefix = opts.NoPrefix
+	ctx.ShowDiff = opts.ShowDiff
+
+	// Track all side effects, ensuring a failed release can be rolled back
+	ctx.Journal = &journal.Journal{}
 
 	// Enable pre-tagging support for generating a changelog
 	ctx.Changelog.PreTag = true
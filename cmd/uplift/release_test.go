@@ -1,9 +1,17 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cgi"
+	"net/http/httptest"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
+	"github.com/gembaadvantage/uplift/internal/pullrequest"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -91,6 +99,39 @@ ci: not a release`
 	require.EqualError(t, err, "no release detected")
 }
 
+func TestRelease_CheckFlagSkipsHooks(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: new feature"),
+		gittest.WithCommittedFiles(".uplift.yml"),
+		gittest.WithFileContent(".uplift.yml", `hooks:
+  always:
+    - touch always.out
+`))
+
+	relCmd := newReleaseCmd(&globalOptions{}, os.Stdout)
+	relCmd.Cmd.SetArgs([]string{"--check"})
+
+	err := relCmd.Cmd.Execute()
+	require.NoError(t, err)
+	assert.NoFileExists(t, "always.out")
+}
+
+func TestRelease_AlwaysHooks(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: new feature"),
+		gittest.WithCommittedFiles(".uplift.yml"),
+		gittest.WithFileContent(".uplift.yml", `hooks:
+  always:
+    - touch always.out
+`))
+
+	relCmd := newReleaseCmd(noChangesPushed(), os.Stdout)
+
+	err := relCmd.Cmd.Execute()
+	require.NoError(t, err)
+	assert.FileExists(t, "always.out")
+}
+
 func TestRelease_PrereleaseFlag(t *testing.T) {
 	log := `refactor: make changes
 feat: new feature
@@ -310,3 +351,147 @@ feat: second commit`
 	assert.NotContains(t, cl, "this line that should be ignored")
 	assert.NotContains(t, cl, "this line that should also be ignored")
 }
+
+func TestRelease_RollbackOnFailure(t *testing.T) {
+	cfg := bumpConfig + `hooks:
+  afterTag:
+    - exit 1
+`
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: new feature"),
+		gittest.WithCommittedFiles("test.txt", ".uplift.yml"),
+		gittest.WithFileContent("test.txt", bumpFile, ".uplift.yml", cfg))
+	lc := gittest.LastCommit(t)
+
+	relCmd := newReleaseCmd(noChangesPushed(), os.Stdout)
+
+	err := relCmd.Cmd.Execute()
+	require.Error(t, err)
+
+	assert.Empty(t, gittest.Tags(t))
+	assert.Equal(t, lc.Hash, gittest.LastCommit(t).Hash)
+	assert.Empty(t, gittest.PorcelainStatus(t))
+	assert.False(t, changelogExists(t))
+
+	actual, err := os.ReadFile("test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, bumpFile, string(actual))
+}
+
+func TestRelease_AtomicPush(t *testing.T) {
+	cfg := bumpConfig + `git:
+  atomicPush: true
+`
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: new feature"),
+		gittest.WithCommittedFiles("test.txt", ".uplift.yml"),
+		gittest.WithFileContent("test.txt", bumpFile, ".uplift.yml", cfg))
+
+	relCmd := newReleaseCmd(&globalOptions{}, os.Stdout)
+
+	err := relCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"v0.1.0"}, gittest.RemoteTags(t))
+	rlog := gittest.RemoteLog(t)
+	assert.Equal(t, "ci(uplift): uplifted for version v0.1.0", rlog[0].Message)
+}
+
+func TestRelease_PullRequestMode(t *testing.T) {
+	cfg := bumpConfig + `release:
+  mode: pull-request
+`
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: new feature"),
+		gittest.WithCommittedFiles("test.txt", ".uplift.yml"),
+		gittest.WithFileContent("test.txt", bumpFile, ".uplift.yml", cfg))
+
+	relCmd := newReleaseCmd(noChangesPushed(), os.Stdout)
+
+	err := relCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.Equal(t, "uplift/release-v0.1.0", gittest.MustExec(t, "git branch --show-current"))
+	assert.Equal(t, "ci(uplift): uplifted for version v0.1.0", gittest.LastCommit(t).Message)
+	assert.Empty(t, gittest.Tags(t))
+}
+
+func TestRelease_PullRequestModePushesBranch(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: new feature"),
+		gittest.WithCommittedFiles("test.txt"),
+		gittest.WithFileContent("test.txt", bumpFile))
+
+	var pull map[string]string
+	srvURL := giteaServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&pull))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number": 1, "html_url": "https://gitea.example.com/owner/repo/pulls/1"}`))
+	})
+
+	cfg := bumpConfig + fmt.Sprintf(`release:
+  mode: pull-request
+gitea:
+  url: %s
+`, srvURL)
+	gittest.TempFile(t, ".uplift.yml", cfg)
+	gittest.StageFile(t, ".uplift.yml")
+	gittest.Commit(t, "ci: configure pull request releases")
+
+	relCmd := newReleaseCmd(&globalOptions{}, os.Stdout)
+
+	err := relCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.LastCommit(t).Hash,
+		gittest.MustExec(t, "git ls-remote --heads origin refs/heads/uplift/release-v0.1.0 | cut -f1"))
+	assert.Empty(t, gittest.MustExec(t, "git ls-remote --tags origin"))
+	assert.Equal(t, "uplift/release-v0.1.0", pull["head"])
+	assert.Equal(t, gittest.DefaultBranch, pull["base"])
+}
+
+// giteaServer serves the remote of the current repository over HTTP using
+// git http-backend, alongside a Gitea API for opening pull requests. The
+// origin remote is updated to point to the server, whose URL is returned
+func giteaServer(t *testing.T, openPull http.HandlerFunc) string {
+	t.Helper()
+
+	root := t.TempDir()
+	remote := filepath.Join(root, "owner", "repo.git")
+	gittest.MustExec(t, fmt.Sprintf("git clone -q --bare %s %s",
+		gittest.MustExec(t, "git remote get-url origin"), remote))
+	gittest.MustExec(t, fmt.Sprintf("git -C %s config http.receivepack true", remote))
+
+	gitPath, err := exec.LookPath("git")
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/repos/owner/repo/pulls", openPull)
+	mux.Handle("/", &cgi.Handler{
+		Path: gitPath,
+		Args: []string{"http-backend"},
+		Env:  []string{"GIT_PROJECT_ROOT=" + root, "GIT_HTTP_EXPORT_ALL=1"},
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	t.Setenv(pullrequest.GiteaTokenEnv, "token")
+
+	gittest.MustExec(t, fmt.Sprintf("git remote set-url origin %s/owner/repo.git", srv.URL))
+	gittest.MustExec(t, "git fetch -q origin")
+	return srv.URL
+}
+
+func TestRelease_FinalizeRequiresPullRequestMode(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: new feature"),
+		gittest.WithCommittedFiles(".uplift.yml"),
+		gittest.WithFileContent(".uplift.yml", bumpConfig))
+
+	relCmd := newReleaseCmd(noChangesPushed(), os.Stdout)
+	relCmd.Cmd.SetArgs([]string{"--finalize"})
+
+	err := relCmd.Cmd.Execute()
+	require.EqualError(t, err, "a release can only be finalized when release.mode is set to pull-request")
+}
@@ -5,7 +5,7 @@ import (
 
 	"github.com/apex/log"
 	"github.com/apex/log/handlers/cli"
-	"github.com/apex/log/handlers/discard"
+	"github.com/gembaadvantage/uplift/internal/logging"
 	"github.com/spf13/cobra"
 )
 
@@ -19,11 +19,26 @@ type globalOptions struct {
 	FilterOnPrerelease       bool
 	IgnoreDetached           bool
 	IgnoreShallow            bool
+	Config                   string
 	ConfigDir                string
+	LogFormat                string
+	LogFile                  string
+	TraceFile                string
+	TraceEndpoint            string
 }
 type rootCommand struct {
 	Cmd  *cobra.Command
 	Opts *globalOptions
+
+	logFile io.Closer
+}
+
+// Close any log file opened by the command
+func (r *rootCommand) Close() error {
+	if r.logFile == nil {
+		return nil
+	}
+	return r.logFile.Close()
 }
 
 func newRootCmd(out io.Writer) *rootCommand {
@@ -38,26 +53,35 @@ func newRootCmd(out io.Writer) *rootCommand {
 		Short:         "Semantic versioning the easy way",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		PersistentPreRun: func(_ *cobra.Command, _ []string) {
-			if rootCmd.Opts.Debug {
-				log.SetLevel(log.InvalidLevel)
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			closer, err := logging.Setup(logging.Options{
+				Format: rootCmd.Opts.LogFormat,
+				File:   rootCmd.Opts.LogFile,
+				Debug:  rootCmd.Opts.Debug,
+				Silent: rootCmd.Opts.Silent,
+			})
+			if err != nil {
+				return err
 			}
 
-			if rootCmd.Opts.Silent {
-				// Switch logging handler, to ensure all logging is discarded
-				log.SetHandler(discard.Default)
-			}
+			rootCmd.logFile = closer
+			return nil
 		},
 	}
 
 	// Persistent flags to be written into the context
 	pf := cmd.PersistentFlags()
+	pf.StringVar(&rootCmd.Opts.Config, "config", "", "a custom path to an uplift config file")
 	pf.StringVar(&rootCmd.Opts.ConfigDir, "config-dir", currentWorkingDir, "a custom path to a directory containing uplift config")
 	pf.BoolVar(&rootCmd.Opts.DryRun, "dry-run", false, "run without making any changes")
 	pf.BoolVar(&rootCmd.Opts.Debug, "debug", false, "show me everything that happens")
 	pf.BoolVar(&rootCmd.Opts.NoPush, "no-push", false, "no changes will be pushed to the git remote")
 	pf.BoolVar(&rootCmd.Opts.NoStage, "no-stage", false, "no changes will be git staged")
 	pf.BoolVar(&rootCmd.Opts.Silent, "silent", false, "silence all logging")
+	pf.StringVar(&rootCmd.Opts.LogFormat, "log-format", logging.FormatCLI, "the format of all logging, either cli, text or json")
+	pf.StringVar(&rootCmd.Opts.LogFile, "log-file", "", "write a copy of all logging to a file")
+	pf.StringVar(&rootCmd.Opts.TraceFile, "trace-file", "", "write a trace of all tasks to a file as OTLP/JSON")
+	pf.StringVar(&rootCmd.Opts.TraceEndpoint, "trace-endpoint", "", "export a trace of all tasks to an OpenTelemetry collector using OTLP/HTTP")
 	pf.BoolVar(&rootCmd.Opts.IgnoreDetached, "ignore-detached", false, "ignore reported git detached HEAD error")
 	pf.BoolVar(&rootCmd.Opts.IgnoreShallow, "ignore-shallow", false, "ignore reported git shallow clone error")
 	pf.BoolVar(&rootCmd.Opts.IgnoreExistingPrerelease, "ignore-existing-prerelease", false, "ignore any existing prerelease when calculating next semantic version")
@@ -68,9 +92,12 @@ func newRootCmd(out io.Writer) *rootCommand {
 		newBumpCmd(rootCmd.Opts, out).Cmd,
 		newTagCmd(rootCmd.Opts, out).Cmd,
 		newReleaseCmd(rootCmd.Opts, out).Cmd,
+		newPlanCmd(rootCmd.Opts, out).Cmd,
 		newChangelogCmd(rootCmd.Opts, out).Cmd,
 		newManPageCmd(out).Cmd,
 		newCheckCmd(rootCmd.Opts, out),
+		newInitCmd(rootCmd.Opts, out),
+		newSchemaCmd(out),
 	)
 
 	rootCmd.Cmd = cmd
@@ -2,8 +2,12 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/cli"
+	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,6 +52,16 @@ func TestRoot_ConfigDir(t *testing.T) {
 	assert.Equal(t, "custom", rootCmd.Opts.ConfigDir)
 }
 
+func TestRoot_Config(t *testing.T) {
+	rootCmd := newRootCmd(os.Stdout)
+
+	rootCmd.Cmd.SetArgs([]string{"--config", "custom/uplift.yml"})
+	err := rootCmd.Cmd.Execute()
+	require.NoError(t, err)
+
+	assert.Equal(t, "custom/uplift.yml", rootCmd.Opts.Config)
+}
+
 func TestRoot_IgnoreDetachedFlag(t *testing.T) {
 	rootCmd := newRootCmd(os.Stdout)
 
@@ -77,3 +91,33 @@ func TestRoot_NoStage(t *testing.T) {
 
 	assert.True(t, rootCmd.Opts.NoStage)
 }
+
+func TestRoot_LogFormatUnsupported(t *testing.T) {
+	gittest.InitRepository(t)
+
+	rootCmd := newRootCmd(os.Stdout)
+	t.Cleanup(func() { log.SetHandler(cli.Default) })
+
+	rootCmd.Cmd.SetArgs([]string{"tag", "--next", "--log-format", "xml"})
+	err := rootCmd.Cmd.Execute()
+	require.EqualError(t, err, "unsupported log format 'xml', must be one of [cli text json]")
+}
+
+func TestRoot_LogFile(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: new feature"))
+
+	rootCmd := newRootCmd(os.Stdout)
+	t.Cleanup(func() { log.SetHandler(cli.Default) })
+
+	path := filepath.Join(t.TempDir(), "uplift.log")
+	rootCmd.Cmd.SetArgs([]string{"tag", "--next", "--no-push", "--log-file", path})
+	err := rootCmd.Cmd.Execute()
+	require.NoError(t, err)
+	require.NoError(t, rootCmd.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"message":"task completed"`)
+	assert.Contains(t, string(data), `"task":"next semantic version"`)
+	assert.Contains(t, string(data), `"outcome":"success"`)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"io"
+	"os"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	schemaLongDesc = `Generate a JSON Schema for the uplift configuration file. The schema is
+generated from the configuration supported by this version of uplift,
+including any validation rules, and can be used by any editor supporting
+the YAML language server.`
+
+	schemaExamples = `
+# Print the JSON Schema
+uplift schema
+
+# Write the JSON Schema to a file
+uplift schema --output schema.json`
+)
+
+type schemaOptions struct {
+	Output string
+}
+
+func newSchemaCmd(out io.Writer) *cobra.Command {
+	opts := schemaOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "schema",
+		Short:   "Generate a JSON Schema for the uplift configuration file",
+		Long:    schemaLongDesc,
+		Example: schemaExamples,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.run(out)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&opts.Output, "output", "o", "", "write the JSON Schema to a file")
+
+	return cmd
+}
+
+func (o schemaOptions) run(out io.Writer) error {
+	schema, err := config.GenerateSchema()
+	if err != nil {
+		return err
+	}
+
+	if o.Output != "" {
+		return os.WriteFile(o.Output, schema, 0o644)
+	}
+
+	_, err = out.Write(schema)
+	return err
+}
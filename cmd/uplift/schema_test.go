@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema(t *testing.T) {
+	var buf bytes.Buffer
+	schemaCmd := newSchemaCmd(&buf)
+	err := schemaCmd.Execute()
+	require.NoError(t, err)
+
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &schema))
+	assert.Equal(t, "Uplift", schema["title"])
+}
+
+func TestSchema_Output(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.json")
+
+	var buf bytes.Buffer
+	schemaCmd := newSchemaCmd(&buf)
+	schemaCmd.SetArgs([]string{"--output", path})
+	err := schemaCmd.Execute()
+	require.NoError(t, err)
+
+	assert.Empty(t, buf.String())
+	assert.FileExists(t, path)
+}
+
+func TestSchema_MatchesDocs(t *testing.T) {
+	var buf bytes.Buffer
+	schemaCmd := newSchemaCmd(&buf)
+	require.NoError(t, schemaCmd.Execute())
+
+	committed, err := os.ReadFile("../../docs/static/schema.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(committed), buf.String())
+}
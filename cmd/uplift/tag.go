@@ -9,13 +9,19 @@ import (
 	"github.com/gembaadvantage/uplift/internal/task"
 	"github.com/gembaadvantage/uplift/internal/task/fetchtag"
 	"github.com/gembaadvantage/uplift/internal/task/gitcheck"
+	"github.com/gembaadvantage/uplift/internal/task/gitpush"
 	"github.com/gembaadvantage/uplift/internal/task/gittag"
+	"github.com/gembaadvantage/uplift/internal/task/gpgimport"
 	"github.com/gembaadvantage/uplift/internal/task/hook/after"
 	"github.com/gembaadvantage/uplift/internal/task/hook/aftertag"
 	"github.com/gembaadvantage/uplift/internal/task/hook/before"
 	"github.com/gembaadvantage/uplift/internal/task/hook/beforetag"
 	"github.com/gembaadvantage/uplift/internal/task/nextcommit"
 	"github.com/gembaadvantage/uplift/internal/task/nextsemver"
+	"github.com/gembaadvantage/uplift/internal/task/plugin"
+	"github.com/gembaadvantage/uplift/internal/task/sshimport"
+	"github.com/gembaadvantage/uplift/internal/task/summary"
+	"github.com/gembaadvantage/uplift/internal/task/verifysig"
 	git "github.com/purpleclay/gitz"
 	"github.com/spf13/cobra"
 )
@@ -66,24 +72,39 @@ var (
 	tagRepoPipeline = []task.Runner{
 		gitcheck.Task{},
 		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
+		gpgimport.Task{},
+		sshimport.Task{},
 		fetchtag.Task{},
 		nextsemver.Task{},
+		verifysig.Task{},
 		nextcommit.Task{},
 		beforetag.Task{},
+		plugin.Task{Stage: plugin.StageBeforeTag},
 		gittag.Task{},
+		gitpush.Task{},
 		aftertag.Task{},
+		plugin.Task{Stage: plugin.StageAfterTag},
 		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
+		summary.Task{},
 	}
 
 	printNextTagPipeline = []task.Runner{
 		gitcheck.Task{},
 		before.Task{},
+		plugin.Task{Stage: plugin.StageBefore},
 		fetchtag.Task{},
 		nextsemver.Task{},
+		verifysig.Task{},
 		beforetag.Task{},
+		plugin.Task{Stage: plugin.StageBeforeTag},
 		gittag.Task{},
+		gitpush.Task{},
 		aftertag.Task{},
+		plugin.Task{Stage: plugin.StageAfterTag},
 		after.Task{},
+		plugin.Task{Stage: plugin.StageAfter},
 	}
 )
 
@@ -154,16 +175,23 @@ func tagRepo(opts tagOptions, out io.Writer) error {
 	}
 
 	tasks := tagRepoPipeline
+	execute := task.ExecuteWithHooks
 
+	// Printing the next tag never modifies the repository
 	if ctx.PrintNextTag {
 		tasks = printNextTagPipeline
+		execute = task.Execute
 	}
 
-	return task.Execute(ctx, tasks)
+	if tasks, err = composePipeline(ctx.Config, "tag", tasks); err != nil {
+		return err
+	}
+
+	return execute(ctx, tasks)
 }
 
 func setupTagContext(opts tagOptions, out io.Writer) (*context.Context, error) {
-	cfg, err := loadConfig(opts.ConfigDir)
+	cfg, err := loadConfig(opts.Config, opts.ConfigDir)
 	if err != nil {
 		fmt.Printf("failed to load uplift config. %v", err)
 		return nil, err
@@ -172,6 +200,8 @@ func setupTagContext(opts tagOptions, out io.Writer) (*context.Context, error) {
 
 	// Set all values within the context
 	ctx.Debug = opts.Debug
+	ctx.TraceFile = opts.TraceFile
+	ctx.TraceEndpoint = opts.TraceEndpoint
 	ctx.DryRun = opts.DryRun
 	ctx.NoPush = opts.NoPush
 	ctx.FetchTags = opts.FetchTags
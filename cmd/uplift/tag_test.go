@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/gembaadvantage/uplift/internal/ssh/sshtest"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -124,3 +125,30 @@ func TestTag_Hooks(t *testing.T) {
 	assert.FileExists(t, AfterTagFile)
 	assert.FileExists(t, AfterFile)
 }
+
+func TestTag_VerifySignaturesAfterFetchingTags(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithLog("feat: an unsigned feature"),
+		gittest.WithCommittedFiles(".uplift.yml"),
+		gittest.WithFileContent(".uplift.yml", `verify:
+  signatures:
+    enabled: true
+`))
+
+	// Only the remote is aware of the latest tag, which excludes all unsigned commits
+	gittest.Tag(t, "v0.1.0")
+	gittest.MustExec(t, "git push -q origin v0.1.0")
+	gittest.MustExec(t, "git tag -d v0.1.0")
+
+	sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "fix: a signed fix")
+
+	var buf bytes.Buffer
+	tagCmd := newTagCmd(noChangesPushed(), &buf)
+	tagCmd.Cmd.SetArgs([]string{"--next", "--fetch-all"})
+
+	err := tagCmd.Cmd.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "v0.1.1", buf.String())
+}
@@ -47,14 +47,14 @@ func configWithHooks(t *testing.T) {
 
 	cfg := &config.Uplift{
 		Hooks: &config.Hooks{
-			Before:          []string{"touch " + BeforeFile},
-			BeforeBump:      []string{"touch " + BeforeBumpFile},
-			BeforeTag:       []string{"touch " + BeforeTagFile},
-			BeforeChangelog: []string{"touch " + BeforeChangelogFile},
-			After:           []string{"touch " + AfterFile},
-			AfterBump:       []string{"touch " + AfterBumpFile},
-			AfterTag:        []string{"touch " + AfterTagFile},
-			AfterChangelog:  []string{"touch " + AfterChangelogFile},
+			Before:          []config.Hook{{Cmd: "touch " + BeforeFile}},
+			BeforeBump:      []config.Hook{{Cmd: "touch " + BeforeBumpFile}},
+			BeforeTag:       []config.Hook{{Cmd: "touch " + BeforeTagFile}},
+			BeforeChangelog: []config.Hook{{Cmd: "touch " + BeforeChangelogFile}},
+			After:           []config.Hook{{Cmd: "touch " + AfterFile}},
+			AfterBump:       []config.Hook{{Cmd: "touch " + AfterBumpFile}},
+			AfterTag:        []config.Hook{{Cmd: "touch " + AfterTagFile}},
+			AfterChangelog:  []config.Hook{{Cmd: "touch " + AfterChangelogFile}},
 		},
 	}
 	data, err := yaml.Marshal(&cfg)
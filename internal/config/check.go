@@ -0,0 +1,372 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// Matches a single error reported by a YAML decoder
+	yamlError = regexp.MustCompile(`^line (\d+): (.*)$`)
+
+	// Matches an error reported by a YAML parser for malformed YAML
+	syntaxError = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+	// Matches an error reported by a YAML decoder for an unknown key
+	unknownField = regexp.MustCompile(`^field (\S+) not found in type (\S+)$`)
+
+	// Matches a segment within the namespace of a validation error, e.g. Bumps[0]
+	namespaceSegment = regexp.MustCompile(`^([^\[]+)(?:\[([^\]]+)\])?$`)
+)
+
+// Diagnostic describes a problem with a single field within the config, at
+// the position of the YAML node that caused it. A position is not known if
+// the field is missing from the config
+type Diagnostic struct {
+	Path       string
+	Message    string
+	Line       int
+	Column     int
+	Suggestion string
+}
+
+// DiagnosticError groups every problem found when checking a config. Each is
+// reported alongside a snippet of the config highlighting its position
+type DiagnosticError struct {
+	Name        string
+	Diagnostics []Diagnostic
+
+	lines []string
+}
+
+// Error reports every problem, each with a snippet of the config
+func (e *DiagnosticError) Error() string {
+	var buf strings.Builder
+	buf.WriteString(validationHeader)
+
+	for i, d := range e.Diagnostics {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		if d.Path != "" {
+			fmt.Fprintf(&buf, " field '%s' %s\n", d.Path, d.Message)
+		} else {
+			fmt.Fprintf(&buf, " %s\n", d.Message)
+		}
+
+		if d.Suggestion != "" {
+			fmt.Fprintf(&buf, " did you mean '%s'?\n", d.Suggestion)
+		}
+
+		if d.Line > 0 && d.Line <= len(e.lines) {
+			buf.WriteString(e.snippet(d))
+		}
+	}
+
+	return buf.String()
+}
+
+func (e *DiagnosticError) snippet(d Diagnostic) string {
+	line := e.lines[d.Line-1]
+
+	col := d.Column
+	if col < 1 {
+		col = len(line) - len(strings.TrimLeft(line, " ")) + 1
+	}
+
+	num := strconv.Itoa(d.Line)
+	pad := strings.Repeat(" ", len(num))
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, " %s--> %s:%d:%d\n", pad, e.Name, d.Line, col)
+	fmt.Fprintf(&buf, " %s |\n", pad)
+	fmt.Fprintf(&buf, " %s | %s\n", num, line)
+	fmt.Fprintf(&buf, " %s | %s^\n", pad, strings.Repeat(" ", col-1))
+	return buf.String()
+}
+
+// Check parses and validates the YAML config, reporting every problem at the
+// position of the YAML node that caused it as a [DiagnosticError]. Any key
+// that is not supported is reported with the closest matching key. The name
+// identifies the config within each report
+func Check(name string, data []byte) (Uplift, error) {
+	diagErr := &DiagnosticError{
+		Name:  name,
+		lines: strings.Split(string(data), "\n"),
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		m := syntaxError.FindStringSubmatch(err.Error())
+		if m == nil {
+			return Uplift{}, err
+		}
+
+		line, _ := strconv.Atoi(m[1])
+		diagErr.Diagnostics = []Diagnostic{{Message: m[2], Line: line}}
+		return Uplift{}, diagErr
+	}
+
+	cfg, err := Parse(data)
+	if err != nil {
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			return cfg, err
+		}
+
+		for _, msg := range typeErr.Errors {
+			diagErr.Diagnostics = append(diagErr.Diagnostics, decodeDiagnostic(&doc, msg))
+		}
+		return cfg, diagErr
+	}
+
+	if err := validator.New().Struct(cfg); err != nil {
+		var valErrs validator.ValidationErrors
+		if !errors.As(err, &valErrs) {
+			return cfg, err
+		}
+
+		for _, fe := range valErrs {
+			diagErr.Diagnostics = append(diagErr.Diagnostics, validationDiagnostic(&doc, fe))
+		}
+		return cfg, diagErr
+	}
+
+	return cfg, nil
+}
+
+// decodeDiagnostic converts an error reported by the YAML decoder
+func decodeDiagnostic(doc *yaml.Node, msg string) Diagnostic {
+	m := yamlError.FindStringSubmatch(msg)
+	if m == nil {
+		return Diagnostic{Message: msg}
+	}
+
+	line, _ := strconv.Atoi(m[1])
+	d := Diagnostic{Message: m[2], Line: line}
+
+	if f := unknownField.FindStringSubmatch(m[2]); f != nil {
+		d.Message = fmt.Sprintf("key '%s' is not supported", f[1])
+		d.Suggestion = suggest(f[1], knownKeys(f[2]))
+
+		if key := findKey(doc, f[1], line); key != nil {
+			d.Column = key.Column
+		}
+	}
+	return d
+}
+
+// validationDiagnostic converts a validation error, locating the YAML node of
+// the field that failed. A missing field is reported at its parent
+func validationDiagnostic(doc *yaml.Node, fe validator.FieldError) Diagnostic {
+	path, node := locate(doc, fe.Namespace())
+
+	d := Diagnostic{
+		Path:    path,
+		Message: reason(fe),
+	}
+	if node != nil {
+		d.Line = node.Line
+		d.Column = node.Column
+	}
+	return d
+}
+
+// locate follows the namespace of a validation error, e.g. Uplift.Bumps[0].File,
+// through both the config structs and YAML nodes. The path of the field as
+// written within the YAML is returned, along with the closest node found. A
+// missing field is located at the key of its parent
+func locate(doc *yaml.Node, namespace string) (string, *yaml.Node) {
+	t := reflect.TypeOf(Uplift{})
+	node := rootNode(doc)
+	closest := node
+
+	var path []string
+	for _, seg := range strings.Split(namespace, ".")[1:] {
+		m := namespaceSegment.FindStringSubmatch(seg)
+		if m == nil {
+			break
+		}
+
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		f, ok := t.FieldByName(m[1])
+		if !ok {
+			break
+		}
+
+		name := propertyName(f)
+		t = f.Type
+		if m[2] != "" {
+			path = append(path, fmt.Sprintf("%s[%s]", name, m[2]))
+			t = t.Elem()
+		} else {
+			path = append(path, name)
+		}
+
+		// Stop searching once a field is missing, or written in its shorthand form
+		if node == nil || node.Kind != yaml.MappingNode {
+			node = nil
+			continue
+		}
+
+		i := indexOf(node, name)
+		if i == -1 {
+			node = nil
+			continue
+		}
+
+		closest = node.Content[i]
+		node = node.Content[i+1]
+		if m[2] != "" {
+			node = indexNode(node, m[2])
+		}
+
+		if node.Kind == yaml.ScalarNode || node.Kind == yaml.SequenceNode {
+			closest = node
+		}
+	}
+
+	if closest != nil && closest.Kind == yaml.MappingNode {
+		closest = keyless(closest)
+	}
+	return strings.Join(path, "."), closest
+}
+
+// keyless returns a node to report a missing key against, when it has no
+// parent key, such as at the root of the config
+func keyless(mapping *yaml.Node) *yaml.Node {
+	if len(mapping.Content) > 0 {
+		return mapping.Content[0]
+	}
+	return nil
+}
+
+func indexNode(node *yaml.Node, index string) *yaml.Node {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		if i, err := strconv.Atoi(index); err == nil && i < len(node.Content) {
+			return node.Content[i]
+		}
+	case yaml.MappingNode:
+		if next := valueOf(node, index); next != nil {
+			return next
+		}
+	}
+
+	// A list written as a single item
+	return node
+}
+
+// findKey searches for a mapping key on the given line
+func findKey(node *yaml.Node, key string, line int) *yaml.Node {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if k := node.Content[i]; k.Value == key && k.Line == line {
+				return k
+			}
+		}
+	}
+
+	for _, child := range node.Content {
+		if found := findKey(child, key, line); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// knownKeys lists every key supported by a config struct, identified by the
+// type name reported by the YAML decoder, e.g. config.Bump. Any unexported
+// type used within a custom unmarshal is matched to its exported struct
+func knownKeys(typeName string) []string {
+	_, name, _ := strings.Cut(typeName, ".")
+
+	var keys []string
+	visitStructs(reflect.TypeOf(Uplift{}), map[reflect.Type]bool{}, func(t reflect.Type) bool {
+		if !strings.EqualFold(t.Name(), name) {
+			return false
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.IsExported() {
+				keys = append(keys, propertyName(f))
+			}
+		}
+		return true
+	})
+	return keys
+}
+
+// visitStructs walks every struct reachable from the given type, until the
+// visitor returns true
+func visitStructs(t reflect.Type, seen map[reflect.Type]bool, visit func(reflect.Type) bool) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	if visit(t) {
+		return true
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if visitStructs(t.Field(i).Type, seen, visit) {
+			return true
+		}
+	}
+	return false
+}
+
+// suggest the closest matching key, if any is close enough to be a typo
+func suggest(key string, candidates []string) string {
+	best := ""
+	bestDist := len(key)/3 + 1
+
+	for _, c := range candidates {
+		if strings.EqualFold(c, key) {
+			return c
+		}
+
+		if d := distance(strings.ToLower(key), strings.ToLower(c)); d <= bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best
+}
+
+// distance calculates the Levenshtein distance between two strings
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,218 @@
+package config
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	cfg, err := Check(".uplift.yml", []byte(`commitMessage: "ci: release"
+tag:
+  sign: true
+`))
+
+	require.NoError(t, err)
+	assert.Equal(t, "ci: release", cfg.CommitMessage)
+}
+
+func TestCheck_UnknownKey(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`commitMesage: "ci: release"
+changelog:
+  sort: asc
+  exlude:
+    - "^docs"
+`))
+
+	var diagErr *DiagnosticError
+	require.True(t, errors.As(err, &diagErr))
+	require.Len(t, diagErr.Diagnostics, 2)
+
+	assert.Equal(t, Diagnostic{
+		Message:    "key 'commitMesage' is not supported",
+		Line:       1,
+		Column:     1,
+		Suggestion: "commitMessage",
+	}, diagErr.Diagnostics[0])
+
+	assert.Equal(t, Diagnostic{
+		Message:    "key 'exlude' is not supported",
+		Line:       4,
+		Column:     3,
+		Suggestion: "exclude",
+	}, diagErr.Diagnostics[1])
+}
+
+func TestCheck_UnknownKeyNoSuggestion(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`nothingLikeAnyKey: true
+`))
+
+	var diagErr *DiagnosticError
+	require.True(t, errors.As(err, &diagErr))
+	require.Len(t, diagErr.Diagnostics, 1)
+	assert.Empty(t, diagErr.Diagnostics[0].Suggestion)
+	assert.NotContains(t, err.Error(), "did you mean")
+}
+
+func TestCheck_UnknownKeyWithinShorthandType(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`hooks:
+  before:
+    - cmdd: echo hello
+`))
+
+	var diagErr *DiagnosticError
+	require.True(t, errors.As(err, &diagErr))
+	require.Len(t, diagErr.Diagnostics, 1)
+	assert.Equal(t, "cmd", diagErr.Diagnostics[0].Suggestion)
+	assert.Equal(t, 3, diagErr.Diagnostics[0].Line)
+	assert.Equal(t, 7, diagErr.Diagnostics[0].Column)
+}
+
+func TestCheck_ValidationError(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`bumps:
+  - file: does-not-exist.txt
+    regex:
+      - pattern: "version: $VERSION"
+`))
+
+	var diagErr *DiagnosticError
+	require.True(t, errors.As(err, &diagErr))
+	require.Len(t, diagErr.Diagnostics, 1)
+
+	assert.Equal(t, Diagnostic{
+		Path:    "bumps[0].file",
+		Message: "contains a path to a file that does not exist 'does-not-exist.txt'",
+		Line:    2,
+		Column:  11,
+	}, diagErr.Diagnostics[0])
+}
+
+func TestCheck_ValidationErrorMissingField(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`pipelines:
+  release:
+    insert:
+      - after: nextsemver
+`))
+
+	var diagErr *DiagnosticError
+	require.True(t, errors.As(err, &diagErr))
+	require.Len(t, diagErr.Diagnostics, 1)
+
+	d := diagErr.Diagnostics[0]
+	assert.Equal(t, "pipelines.release.insert[0].step", d.Path)
+	assert.Equal(t, "must be provided", d.Message)
+	assert.Equal(t, 3, d.Line)
+	assert.Equal(t, 5, d.Column)
+}
+
+func TestCheck_ValidationErrorShorthand(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`extends:
+  from: base.yml
+  sha256: abc
+`))
+
+	var diagErr *DiagnosticError
+	require.True(t, errors.As(err, &diagErr))
+	require.Len(t, diagErr.Diagnostics, 1)
+
+	d := diagErr.Diagnostics[0]
+	assert.Equal(t, "extends[0].sha256", d.Path)
+	assert.Equal(t, "contains a value that does not have the expected length of '64'", d.Message)
+	assert.Equal(t, 3, d.Line)
+	assert.Equal(t, 11, d.Column)
+}
+
+func TestCheck_SyntaxError(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`commitMessage: "ci: release"
+bumps: [
+`))
+
+	var diagErr *DiagnosticError
+	require.True(t, errors.As(err, &diagErr))
+	require.Len(t, diagErr.Diagnostics, 1)
+	assert.NotZero(t, diagErr.Diagnostics[0].Line)
+}
+
+func TestDiagnosticError_Error(t *testing.T) {
+	_, err := Check(".uplift.yml", []byte(`changelog:
+  sort: up
+tagg:
+  sign: true
+`))
+
+	require.EqualError(t, err, `uplift configuration contains validation errors. Please fix before proceeding:
+
+ key 'tagg' is not supported
+ did you mean 'tag'?
+  --> .uplift.yml:3:1
+   |
+ 3 | tagg:
+   | ^
+`)
+
+	_, err = Check(".uplift.yml", []byte(`changelog:
+  sort: up
+`))
+
+	require.EqualError(t, err, `uplift configuration contains validation errors. Please fix before proceeding:
+
+ field 'changelog.sort' contains a value that is not one of the following [asc desc ASC DESC]
+  --> .uplift.yml:2:9
+   |
+ 2 |   sort: up
+   |         ^
+`)
+}
+
+func TestDescribeRule_AllTagsDescribed(t *testing.T) {
+	tags := map[string]bool{}
+	visitStructs(reflect.TypeOf(Uplift{}), map[reflect.Type]bool{}, func(t reflect.Type) bool {
+		for i := 0; i < t.NumField(); i++ {
+			for _, rule := range strings.Split(t.Field(i).Tag.Get("validate"), ",") {
+				tag, _, _ := strings.Cut(rule, "=")
+				tags[tag] = true
+			}
+		}
+		return false
+	})
+
+	// Tags that control how validation is applied, rather than failing it
+	delete(tags, "")
+	delete(tags, "dive")
+	delete(tags, "omitempty")
+
+	for tag := range tags {
+		assert.NotContains(t, describeRule(tag, "", reflect.String, ""), "failed the validation rule", "tag '%s' is not described", tag)
+	}
+}
+
+func TestDescribeRule_Min(t *testing.T) {
+	tests := []struct {
+		kind     reflect.Kind
+		expected string
+	}{
+		{kind: reflect.String, expected: "contains a value that does not meet the minimum expected length of '1'"},
+		{kind: reflect.Slice, expected: "contains a value that does not meet the minimum expected length of '1'"},
+		{kind: reflect.Map, expected: "contains a value that does not meet the minimum expected length of '1'"},
+		{kind: reflect.Int, expected: "must be at least '1'"},
+		{kind: reflect.Int64, expected: "must be at least '1'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, describeRule("min", "1", tt.kind, nil))
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	keys := []string{"annotatedTags", "bumps", "changelog", "commitMessage", "commitAuthor"}
+
+	assert.Equal(t, "bumps", suggest("bump", keys))
+	assert.Equal(t, "changelog", suggest("ChangeLog", keys))
+	assert.Equal(t, "commitAuthor", suggest("comitAuthor", keys))
+	assert.Empty(t, suggest("release", keys))
+}
@@ -0,0 +1,385 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	"gopkg.in/yaml.v3"
+)
+
+const extendsKey = "extends"
+
+// Lists within a base config that are appended to by any config extending it,
+// rather than replaced. Paths are matched using [path.Match]
+var appendPaths = []string{
+	"bumps",
+	"env",
+	"plugins",
+	"changelog/exclude",
+	"changelog/include",
+	"git/pushOptions",
+	"hooks/*",
+}
+
+// The client used for fetching a base config from a URL
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// Resolve reads the YAML config file, merging any base configs it extends into
+// a single effective config. A config that extends nothing is returned as is.
+//
+// Base configs are merged in the order they are listed, before the config
+// extending them. Within a mapping, any value defined by the extending config
+// replaces that of its base, except for the lists within [appendPaths], which
+// are appended to. A base config can itself extend other configs
+func Resolve(f string) ([]byte, error) {
+	data, err := os.ReadFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	if root := rootNode(&doc); root == nil || valueOf(root, extendsKey) == nil {
+		return data, nil
+	}
+
+	r := resolver{}
+	merged, err := r.resolve(source{path: f}, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(merged); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// source identifies where a config was loaded from, either a local path or a URL
+type source struct {
+	path string
+	url  *url.URL
+}
+
+func (s source) String() string {
+	if s.url != nil {
+		return s.url.String()
+	}
+	return s.path
+}
+
+// join resolves a reference to a base config, relative to the config that extends it
+func (s source) join(ref string) (source, error) {
+	if u, err := url.Parse(ref); err == nil && u.Scheme != "" && u.Host != "" {
+		if u.Scheme != "https" {
+			return source{}, fmt.Errorf("extends '%s': only https urls are supported", ref)
+		}
+		return source{url: u}, nil
+	}
+
+	if s.url != nil {
+		u, err := s.url.Parse(ref)
+		if err != nil {
+			return source{}, fmt.Errorf("extends '%s': %w", ref, err)
+		}
+		return source{url: u}, nil
+	}
+
+	if filepath.IsAbs(ref) {
+		return source{path: ref}, nil
+	}
+	return source{path: filepath.Join(filepath.Dir(s.path), ref)}, nil
+}
+
+type resolver struct {
+	// Every config currently being resolved, used for detecting cycles
+	chain []string
+}
+
+func (r *resolver) resolve(src source, doc *yaml.Node) (*yaml.Node, error) {
+	root := rootNode(doc)
+	if root == nil {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s: config must be a mapping", src)
+	}
+
+	ext := removeKey(root, extendsKey)
+	if ext == nil {
+		return root, nil
+	}
+
+	var extends ExtendList
+	if err := ext.Decode(&extends); err != nil {
+		return nil, fmt.Errorf("%s: invalid extends: %w", src, err)
+	}
+
+	r.chain = append(r.chain, src.String())
+	defer func() {
+		r.chain = r.chain[:len(r.chain)-1]
+	}()
+
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, e := range extends {
+		base, err := r.extend(src, e)
+		if err != nil {
+			return nil, err
+		}
+		merged = merge(merged, base, "")
+	}
+
+	return merge(merged, root, ""), nil
+}
+
+func (r *resolver) extend(src source, e Extend) (*yaml.Node, error) {
+	if e.From == "" {
+		return nil, fmt.Errorf("%s: extends must provide a path or url", src)
+	}
+
+	if e.SHA256 != "" {
+		if b, err := hex.DecodeString(e.SHA256); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("extends '%s': sha256 must be a 64 character hex string", e.From)
+		}
+	}
+
+	base, err := src.join(e.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(r.chain, base.String()) {
+		return nil, fmt.Errorf("extends '%s': cyclic dependency detected [%s -> %s]",
+			e.From, strings.Join(r.chain, " -> "), base)
+	}
+
+	var data []byte
+	if base.url != nil {
+		data, err = fetch(base.url, e.SHA256)
+	} else {
+		data, err = os.ReadFile(base.path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("extends '%s': %w", e.From, err)
+	}
+
+	if base.url == nil && e.SHA256 != "" {
+		if err := verify(data, e.SHA256); err != nil {
+			return nil, fmt.Errorf("extends '%s': %w", e.From, err)
+		}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("extends '%s': %w", e.From, err)
+	}
+
+	return r.resolve(base, &doc)
+}
+
+// merge the override node into the base node, returning the result. Neither
+// node is modified
+func merge(base, override *yaml.Node, at string) *yaml.Node {
+	if base.Kind == yaml.MappingNode && override.Kind == yaml.MappingNode {
+		merged := *base
+		merged.Content = slices.Clone(base.Content)
+
+		for i := 0; i+1 < len(override.Content); i += 2 {
+			key, value := override.Content[i], override.Content[i+1]
+
+			if j := indexOf(&merged, key.Value); j != -1 {
+				merged.Content[j+1] = merge(merged.Content[j+1], value, path.Join(at, key.Value))
+			} else {
+				merged.Content = append(merged.Content, key, value)
+			}
+		}
+		return &merged
+	}
+
+	if base.Kind == yaml.SequenceNode && override.Kind == yaml.SequenceNode && appends(at) {
+		merged := *override
+		merged.Content = append(slices.Clone(base.Content), override.Content...)
+		return &merged
+	}
+
+	return override
+}
+
+func appends(at string) bool {
+	for _, p := range appendPaths {
+		if ok, _ := path.Match(p, at); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func rootNode(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil
+	}
+	return doc.Content[0]
+}
+
+func indexOf(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func valueOf(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	if i := indexOf(mapping, key); i != -1 {
+		return mapping.Content[i+1]
+	}
+	return nil
+}
+
+func removeKey(mapping *yaml.Node, key string) *yaml.Node {
+	i := indexOf(mapping, key)
+	if i == -1 {
+		return nil
+	}
+
+	value := mapping.Content[i+1]
+	mapping.Content = slices.Delete(mapping.Content, i, i+2)
+	return value
+}
+
+// fetch a base config from a URL. Every fetched config is cached alongside the
+// SHA256 hash of its contents. A pinned config is served from the cache while
+// its hash matches, otherwise the cache is only used if the URL is unreachable
+func fetch(u *url.URL, pinned string) ([]byte, error) {
+	cached, cacheErr := readCache(u)
+	if pinned != "" && cacheErr == nil && verify(cached, pinned) == nil {
+		log.WithField("url", u.String()).Debug("using cached base config")
+		return cached, nil
+	}
+
+	data, err := download(u)
+	if err != nil {
+		if pinned == "" && cacheErr == nil {
+			log.WithError(err).WithField("url", u.String()).Warn("failed to fetch base config, using cached copy")
+			return cached, nil
+		}
+		return nil, err
+	}
+
+	if pinned != "" {
+		if err := verify(data, pinned); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeCache(u, data); err != nil {
+		log.WithError(err).WithField("url", u.String()).Debug("failed to cache base config")
+	}
+	return data, nil
+}
+
+func download(u *url.URL) ([]byte, error) {
+	resp, err := httpClient.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch config, received status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func verify(data []byte, expected string) error {
+	if actual := checksum(data); !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("integrity check failed, expected sha256 %s but was %s", expected, actual)
+	}
+	return nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func cachePaths(u *url.URL) (string, string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", "", err
+	}
+
+	key := checksum([]byte(u.String()))
+	base := filepath.Join(dir, "uplift", "extends", key)
+	return base + ".yml", base + ".sha256", nil
+}
+
+// readCache returns a previously fetched config, only if its contents still
+// match the hash recorded when it was cached
+func readCache(u *url.URL) ([]byte, error) {
+	dataPath, sumPath, err := cachePaths(u)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(dataPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sum, err := os.ReadFile(sumPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verify(data, strings.TrimSpace(string(sum))); err != nil {
+		return nil, errors.New("cached config is corrupt")
+	}
+	return data, nil
+}
+
+func writeCache(u *url.URL, data []byte) error {
+	dataPath, sumPath, err := cachePaths(u)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dataPath), 0o755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(dataPath, data, 0o644); err != nil {
+		return err
+	}
+	return os.WriteFile(sumPath, []byte(checksum(data)+"\n"), 0o644)
+}
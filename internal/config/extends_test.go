@@ -0,0 +1,284 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, dir, name, s string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(s), 0o644))
+	return path
+}
+
+func TestResolve_NoExtends(t *testing.T) {
+	cfg := `# keep this comment
+commitMessage: "ci: release"
+`
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", cfg)
+
+	data, err := Resolve(path)
+	require.NoError(t, err)
+	assert.Equal(t, cfg, string(data))
+}
+
+func TestResolve_MergeRules(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, "shared/base.yml", `annotatedTags: true
+commitMessage: "ci: base"
+env:
+  - BASE=1
+changelog:
+  sort: asc
+  exclude:
+    - "^chore"
+hooks:
+  before:
+    - echo base
+bumps:
+  - file: base.txt
+    regex:
+      - pattern: "version: $VERSION"
+git:
+  pushOptions:
+    - base
+  ignoreDetached: true
+pipelines:
+  release:
+    disable:
+      - gitcheck
+`)
+	path := writeConfig(t, dir, ".uplift.yml", `extends: shared/base.yml
+annotatedTags: false
+env:
+  - LOCAL=1
+changelog:
+  exclude:
+    - "^docs"
+hooks:
+  before:
+    - echo local
+bumps:
+  - file: local.txt
+    regex:
+      - pattern: "version: $VERSION"
+git:
+  pushOptions:
+    - local
+pipelines:
+  release:
+    disable:
+      - scm
+`)
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Empty(t, cfg.Extends)
+	assert.False(t, cfg.AnnotatedTags)
+	assert.Equal(t, "ci: base", cfg.CommitMessage)
+	assert.Equal(t, []string{"BASE=1", "LOCAL=1"}, cfg.Env)
+	assert.Equal(t, "asc", cfg.Changelog.Sort)
+	assert.Equal(t, []string{"^chore", "^docs"}, cfg.Changelog.Exclude)
+	assert.Equal(t, HookList{{Cmd: "echo base"}, {Cmd: "echo local"}}, cfg.Hooks.Before)
+	require.Len(t, cfg.Bumps, 2)
+	assert.Equal(t, "base.txt", cfg.Bumps[0].File)
+	assert.Equal(t, "local.txt", cfg.Bumps[1].File)
+	assert.Equal(t, []GitPushOption{{Option: "base"}, {Option: "local"}}, cfg.Git.PushOptions)
+	assert.True(t, cfg.Git.IgnoreDetached)
+
+	// Lists not within the append rules are replaced
+	assert.Equal(t, []string{"scm"}, cfg.Pipelines.Release.Disable)
+}
+
+func TestResolve_MultipleAndNested(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, "org/root.yml", `env:
+  - ROOT=1
+commitMessage: "ci: root"
+`)
+	writeConfig(t, dir, "org/team.yml", `extends: root.yml
+env:
+  - TEAM=1
+`)
+	writeConfig(t, dir, "org/signing.yml", `commitMessage: "ci: signing"
+tag:
+  sign: true
+`)
+	path := writeConfig(t, dir, ".uplift.yml", `extends:
+  - org/team.yml
+  - from: org/signing.yml
+env:
+  - LOCAL=1
+`)
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ROOT=1", "TEAM=1", "LOCAL=1"}, cfg.Env)
+	assert.Equal(t, "ci: signing", cfg.CommitMessage)
+	assert.True(t, cfg.Tag.Sign)
+}
+
+func TestResolve_Cycle(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, "a.yml", "extends: b.yml\n")
+	writeConfig(t, dir, "b.yml", "extends: a.yml\n")
+	path := writeConfig(t, dir, ".uplift.yml", "extends: a.yml\n")
+
+	_, err := Resolve(path)
+	require.ErrorContains(t, err, "extends 'a.yml': cyclic dependency detected")
+}
+
+func TestResolve_MissingBase(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", "extends: missing.yml\n")
+
+	_, err := Resolve(path)
+	require.ErrorContains(t, err, "extends 'missing.yml'")
+}
+
+func TestResolve_HTTPNotSupported(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", "extends: http://example.com/uplift.yml\n")
+
+	_, err := Resolve(path)
+	require.EqualError(t, err, "extends 'http://example.com/uplift.yml': only https urls are supported")
+}
+
+func TestResolve_InvalidSHA256(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", `extends:
+  from: base.yml
+  sha256: abc
+`)
+
+	_, err := Resolve(path)
+	require.EqualError(t, err, "extends 'base.yml': sha256 must be a 64 character hex string")
+}
+
+const remoteBase = `commitMessage: "ci: remote"
+env:
+  - REMOTE=1
+`
+
+func remoteServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		switch r.URL.Path {
+		case "/uplift.yml":
+			w.Write([]byte(remoteBase))
+		case "/nested.yml":
+			w.Write([]byte("extends: uplift.yml\nenv:\n  - NESTED=1\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client := httpClient
+	httpClient = srv.Client()
+	t.Cleanup(func() { httpClient = client })
+
+	return srv
+}
+
+func TestResolve_Remote(t *testing.T) {
+	var requests int
+	srv := remoteServer(t, &requests)
+
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", "extends: "+srv.URL+"/nested.yml\n")
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ci: remote", cfg.CommitMessage)
+	assert.Equal(t, []string{"REMOTE=1", "NESTED=1"}, cfg.Env)
+	assert.Equal(t, 2, requests)
+}
+
+func TestResolve_RemoteNotFound(t *testing.T) {
+	var requests int
+	srv := remoteServer(t, &requests)
+
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", "extends: "+srv.URL+"/missing.yml\n")
+
+	_, err := Resolve(path)
+	require.ErrorContains(t, err, "failed to fetch config, received status 404")
+}
+
+func TestResolve_RemotePinnedUsesCache(t *testing.T) {
+	var requests int
+	srv := remoteServer(t, &requests)
+
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", `extends:
+  from: `+srv.URL+`/uplift.yml
+  sha256: `+checksum([]byte(remoteBase))+`
+`)
+
+	_, err := Resolve(path)
+	require.NoError(t, err)
+
+	_, err = Resolve(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestResolve_RemoteIntegrityMismatch(t *testing.T) {
+	var requests int
+	srv := remoteServer(t, &requests)
+
+	pinned := checksum([]byte("tampered"))
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", `extends:
+  from: `+srv.URL+`/uplift.yml
+  sha256: `+pinned+`
+`)
+
+	_, err := Resolve(path)
+	require.EqualError(t, err, "extends '"+srv.URL+"/uplift.yml': integrity check failed, expected sha256 "+
+		pinned+" but was "+checksum([]byte(remoteBase)))
+}
+
+func TestResolve_RemoteUnreachableUsesCache(t *testing.T) {
+	var requests int
+	srv := remoteServer(t, &requests)
+
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", "extends: "+srv.URL+"/uplift.yml\n")
+
+	_, err := Resolve(path)
+	require.NoError(t, err)
+
+	srv.Close()
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "ci: remote", cfg.CommitMessage)
+}
+
+func TestResolve_CorruptCacheIgnored(t *testing.T) {
+	var requests int
+	srv := remoteServer(t, &requests)
+
+	path := writeConfig(t, t.TempDir(), ".uplift.yml", "extends: "+srv.URL+"/uplift.yml\n")
+
+	_, err := Resolve(path)
+	require.NoError(t, err)
+
+	u, _ := (source{}).join(srv.URL + "/uplift.yml")
+	dataPath, _, err := cachePaths(u.url)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dataPath, []byte("commitMessage: tampered\n"), 0o644))
+
+	srv.Close()
+	_, err = Resolve(path)
+	require.Error(t, err)
+}
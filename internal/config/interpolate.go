@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// Matches either an escaped $${ or an environment variable of the form
+// ${VAR} or ${VAR:-default}
+var envVar = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// Fields that are never interpolated when loading the config. Hooks are
+// interpreted by a shell and rendered as templates when executed
+var skipInterpolation = map[string]bool{
+	"hooks": true,
+}
+
+// templateData is available to every config value written as a template
+type templateData struct {
+	Env map[string]string
+}
+
+// interpolate expands all environment variables within the string values of
+// the config. A value can reference a variable as ${VAR} or ${VAR:-default},
+// which expands to an empty string or the default if the variable is not set.
+// A value can also be written as a Go template, e.g. {{ .Env.VAR }}, which
+// fails if the variable is not set. Use $${VAR} to keep a literal ${VAR}
+func interpolate(cfg *Uplift) error {
+	data := templateData{Env: environ()}
+	return interpolateValue(reflect.ValueOf(cfg).Elem(), "", data)
+}
+
+func interpolateValue(v reflect.Value, path string, data templateData) error {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Kind() == reflect.Interface {
+			// Values within an interface cannot be set directly
+			elem := reflect.New(v.Elem().Type()).Elem()
+			elem.Set(v.Elem())
+			if err := interpolateValue(elem, path, data); err != nil {
+				return err
+			}
+			v.Set(elem)
+			return nil
+		}
+		return interpolateValue(v.Elem(), path, data)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+			if name == "" {
+				name = f.Name
+			}
+
+			fpath := joinPath(path, name)
+			if skipInterpolation[fpath] {
+				continue
+			}
+
+			if err := interpolateValue(v.Field(i), fpath, data); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := interpolateValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i), data); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+			if err := interpolateValue(elem, joinPath(path, fmt.Sprint(iter.Key())), data); err != nil {
+				return err
+			}
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	case reflect.String:
+		s, err := expand(v.String(), data)
+		if err != nil {
+			return fmt.Errorf("failed to interpolate field '%s': %w", path, err)
+		}
+		v.SetString(s)
+	}
+
+	return nil
+}
+
+func expand(s string, data templateData) (string, error) {
+	if strings.Contains(s, "{{") {
+		tmpl, err := template.New("").Option("missingkey=error").Parse(s)
+		if err != nil {
+			return "", err
+		}
+
+		var buf strings.Builder
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return "", err
+		}
+		s = buf.String()
+	}
+
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+
+	return envVar.ReplaceAllStringFunc(s, func(m string) string {
+		if m == "$${" {
+			return "${"
+		}
+
+		sub := envVar.FindStringSubmatch(m)
+		if val := os.Getenv(sub[1]); val != "" {
+			return val
+		}
+		return sub[2]
+	}), nil
+}
+
+func environ() map[string]string {
+	env := map[string]string{}
+	for _, e := range os.Environ() {
+		if k, v, ok := strings.Cut(e, "="); ok {
+			env[k] = v
+		}
+	}
+	return env
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
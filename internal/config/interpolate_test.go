@@ -0,0 +1,78 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_InterpolatesEnv(t *testing.T) {
+	t.Setenv("UPLIFT_EMAIL", "bot@example.com")
+	t.Setenv("GITLAB_HOST", "gitlab.internal")
+	t.Setenv("REGISTRY", "artifacts.internal")
+
+	cfg, err := Parse([]byte(`commitMessage: "ci: release $VERSION by ${USER_NAME:-uplift}"
+commitAuthor:
+  name: "{{ .Env.UPLIFT_EMAIL }}"
+  email: ${UPLIFT_EMAIL}
+gitlab:
+  url: https://${GITLAB_HOST}/
+bumps:
+  - file: version.txt
+    regex:
+      - pattern: "version: $VERSION ${MISSING}$${LITERAL}"
+plugins:
+  - name: publish
+    cmd: ./publish
+    with:
+      registry: ${REGISTRY}
+      tags:
+        - "{{ .Env.REGISTRY }}/latest"
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "ci: release $VERSION by uplift", cfg.CommitMessage)
+	assert.Equal(t, "bot@example.com", cfg.CommitAuthor.Name)
+	assert.Equal(t, "bot@example.com", cfg.CommitAuthor.Email)
+	assert.Equal(t, "https://gitlab.internal/", cfg.GitLab.URL)
+	assert.Equal(t, "version: $VERSION ${LITERAL}", cfg.Bumps[0].Regex[0].Pattern)
+	assert.Equal(t, map[string]interface{}{
+		"registry": "artifacts.internal",
+		"tags":     []interface{}{"artifacts.internal/latest"},
+	}, cfg.Plugins[0].With)
+}
+
+func TestParse_InterpolateSkipsHooks(t *testing.T) {
+	t.Setenv("NAME", "uplift")
+
+	cfg, err := Parse([]byte(`hooks:
+  before:
+    - echo ${NAME} {{ .NextVersion }}
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "echo ${NAME} {{ .NextVersion }}", cfg.Hooks.Before[0].Cmd)
+}
+
+func TestParse_InterpolateDefaultWhenEmpty(t *testing.T) {
+	t.Setenv("BRANCH_PREFIX", "")
+
+	cfg, err := Parse([]byte(`release:
+  branchPrefix: ${BRANCH_PREFIX:-release/}
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "release/", cfg.Release.BranchPrefix)
+}
+
+func TestParse_InterpolateTemplateMissingEnv(t *testing.T) {
+	_, err := Parse([]byte(`commitMessage: "{{ .Env.UPLIFT_MISSING_VARIABLE }}"`))
+	require.ErrorContains(t, err, "failed to interpolate field 'commitMessage'")
+}
+
+func TestParse_InterpolateTemplateInvalid(t *testing.T) {
+	_, err := Parse([]byte(`commitAuthor:
+  email: "{{ .Env.EMAIL"`))
+	require.ErrorContains(t, err, "failed to interpolate field 'commitAuthor.email'")
+}
@@ -0,0 +1,507 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	schemaDraft = "https://json-schema.org/draft/2019-09/schema"
+	schemaID    = "https://github.com/gembaadvantage/uplift/internal/config/uplift"
+	docsURL     = "https://upliftci.dev/reference/config#"
+
+	// Matches any duration supported by time.ParseDuration
+	durationPattern = `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Types that can also be written as a non-empty string, which is expanded
+// into the type by a custom YAML unmarshal
+var stringShorthands = map[reflect.Type]string{
+	reflect.TypeOf(Extend{}):        "The local path or HTTPS URL of a base config",
+	reflect.TypeOf(GitPushOption{}): "A push option that will be appended to a git push operation within Uplift",
+	reflect.TypeOf(Hook{}):          "A shell command or script to execute",
+}
+
+// Lists that can also be written as a single item
+var singleOrList = map[reflect.Type]bool{
+	reflect.TypeOf(ExtendList{}): true,
+	reflect.TypeOf(HookList{}):   true,
+}
+
+// JSONSchema describes a JSON Schema, or any of its subschemas. Only the
+// keywords needed for describing the uplift config are supported
+type JSONSchema struct {
+	Schema               string        `json:"$schema,omitempty"`
+	ID                   string        `json:"$id,omitempty"`
+	Ref                  string        `json:"$ref,omitempty"`
+	Comment              string        `json:"$comment,omitempty"`
+	Title                string        `json:"title,omitempty"`
+	Description          string        `json:"description,omitempty"`
+	Deprecated           bool          `json:"deprecated,omitempty"`
+	Type                 string        `json:"type,omitempty"`
+	Format               string        `json:"format,omitempty"`
+	Pattern              string        `json:"pattern,omitempty"`
+	Enum                 []string      `json:"enum,omitempty"`
+	MinLength            *int          `json:"minLength,omitempty"`
+	MaxLength            *int          `json:"maxLength,omitempty"`
+	Minimum              *int          `json:"minimum,omitempty"`
+	Items                *JSONSchema   `json:"items,omitempty"`
+	MinItems             *int          `json:"minItems,omitempty"`
+	Properties           *SchemaMap    `json:"properties,omitempty"`
+	AdditionalProperties *bool         `json:"additionalProperties,omitempty"`
+	Required             []string      `json:"required,omitempty"`
+	AnyOf                []*JSONSchema `json:"anyOf,omitempty"`
+	AllOf                []*JSONSchema `json:"allOf,omitempty"`
+	Not                  *JSONSchema   `json:"not,omitempty"`
+	If                   *JSONSchema   `json:"if,omitempty"`
+	Then                 *JSONSchema   `json:"then,omitempty"`
+	Const                string        `json:"const,omitempty"`
+	Definitions          *SchemaMap    `json:"definitions,omitempty"`
+}
+
+// SchemaMap is a set of named schemas, that retains the order in which
+// they were added when encoded as JSON
+type SchemaMap struct {
+	keys    []string
+	schemas map[string]*JSONSchema
+}
+
+// Set adds a named schema, replacing any existing schema with the same name
+func (m *SchemaMap) Set(name string, s *JSONSchema) {
+	if m.schemas == nil {
+		m.schemas = map[string]*JSONSchema{}
+	}
+
+	if _, ok := m.schemas[name]; !ok {
+		m.keys = append(m.keys, name)
+	}
+	m.schemas[name] = s
+}
+
+// Get a named schema
+func (m *SchemaMap) Get(name string) (*JSONSchema, bool) {
+	s, ok := m.schemas[name]
+	return s, ok
+}
+
+// MarshalJSON encodes each schema in the order they were added
+func (m SchemaMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		value, err := marshalSchema(m.schemas[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// GenerateSchema generates a JSON Schema for the uplift config, by reflecting
+// over the [Uplift] struct. Properties are named after each yaml tag, with
+// any constraints derived from each validate tag. Every field must have a
+// description within [schemaDescriptions]
+func GenerateSchema() ([]byte, error) {
+	g := &schemaGenerator{definitions: &SchemaMap{}}
+
+	root, err := g.object(reflect.TypeOf(Uplift{}), "")
+	if err != nil {
+		return nil, err
+	}
+
+	root.Schema = schemaDraft
+	root.ID = schemaID
+	root.Title = "Uplift"
+	root.Description = "A JSON schema for the Uplift configuration file"
+	root.Definitions = g.definitions
+
+	data, err := marshalSchema(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func marshalSchema(s *JSONSchema) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+type schemaGenerator struct {
+	definitions *SchemaMap
+}
+
+// object generates the schema of a struct. The anchor identifies the section
+// of the config reference documenting the struct
+func (g *schemaGenerator) object(t reflect.Type, anchor string) (*JSONSchema, error) {
+	s := &JSONSchema{
+		Type:                 "object",
+		Properties:           &SchemaMap{},
+		AdditionalProperties: new(bool),
+	}
+
+	names := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		names[f.Name] = propertyName(f)
+	}
+
+	// Rules comparing fields are defined against each field being compared,
+	// so the same constraint can be derived more than once
+	var constraints []*JSONSchema
+	seen := map[string]bool{}
+	addConstraint := func(key string, c *JSONSchema) {
+		if !seen[key] {
+			seen[key] = true
+			constraints = append(constraints, c)
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name := names[f.Name]
+		fanchor := anchor
+		if fanchor == "" {
+			fanchor = name
+		}
+
+		desc, ok := schemaDescriptions[t.Name()+"."+name]
+		if !ok {
+			return nil, fmt.Errorf("missing schema description for field '%s.%s'", t.Name(), name)
+		}
+
+		tag := f.Tag.Get("validate")
+		prop, err := g.schema(f.Type, tag, fanchor)
+		if err != nil {
+			return nil, err
+		}
+
+		prop.Comment = docsURL + fanchor
+		prop.Description = desc
+		prop.Deprecated = strings.HasPrefix(desc, "Deprecated")
+		s.Properties.Set(name, prop)
+
+		outer, _ := splitValidateTag(tag)
+		if implicitlyRequired(f.Type, outer) {
+			s.Required = append(s.Required, name)
+		}
+
+		for _, r := range outer {
+			switch r.tag {
+			case "required":
+				s.Required = append(s.Required, name)
+			case "required_without", "required_without_all":
+				group := []string{name}
+				for _, p := range strings.Fields(r.param) {
+					group = append(group, names[p])
+				}
+				addConstraint("anyOf:"+sortedKey(group), anyRequired(group))
+			case "excluded_with":
+				for _, p := range strings.Fields(r.param) {
+					pair := []string{name, names[p]}
+					addConstraint("not:"+sortedKey(pair), &JSONSchema{Not: &JSONSchema{Required: pair}})
+				}
+			case "required_if":
+				field, value, _ := strings.Cut(r.param, " ")
+				addConstraint("if:"+name, &JSONSchema{
+					If: &JSONSchema{
+						Properties: singleProperty(names[field], &JSONSchema{Const: value}),
+						Required:   []string{names[field]},
+					},
+					Then: &JSONSchema{Required: []string{name}},
+				})
+			}
+		}
+	}
+
+	switch len(constraints) {
+	case 0:
+	case 1:
+		c := constraints[0]
+		s.AnyOf, s.Not, s.If, s.Then = c.AnyOf, c.Not, c.If, c.Then
+	default:
+		s.AllOf = constraints
+	}
+
+	return s, nil
+}
+
+// schema generates the schema of a type, applying the rules of its validate tag
+func (g *schemaGenerator) schema(t reflect.Type, tag, anchor string) (*JSONSchema, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	outer, inner := splitValidateTag(tag)
+
+	if singleOrList[t] {
+		item, err := g.schema(t.Elem(), joinRules(inner), anchor)
+		if err != nil {
+			return nil, err
+		}
+
+		return &JSONSchema{
+			AnyOf: []*JSONSchema{
+				{Type: "array", Items: item, MinItems: intPtr(1)},
+				item,
+			},
+		}, nil
+	}
+
+	if t == reflect.TypeOf(SummaryFile{}) {
+		return &JSONSchema{
+			AnyOf: []*JSONSchema{
+				{Type: "boolean"},
+				{Type: "string", MinLength: intPtr(1)},
+			},
+		}, nil
+	}
+
+	var s *JSONSchema
+	switch t.Kind() {
+	case reflect.Bool:
+		s = &JSONSchema{Type: "boolean"}
+	case reflect.String:
+		s = &JSONSchema{Type: "string"}
+	case reflect.Int64:
+		if t == durationType {
+			s = &JSONSchema{Type: "string", Pattern: durationPattern}
+		} else {
+			s = &JSONSchema{Type: "integer"}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		s = &JSONSchema{Type: "integer"}
+	case reflect.Slice:
+		items, err := g.schema(t.Elem(), joinRules(inner), anchor)
+		if err != nil {
+			return nil, err
+		}
+		s = &JSONSchema{Type: "array", Items: items}
+	case reflect.Map:
+		s = &JSONSchema{Type: "object"}
+	case reflect.Interface:
+		s = &JSONSchema{}
+	case reflect.Struct:
+		ref, err := g.define(t, anchor)
+		if err != nil {
+			return nil, err
+		}
+		s = &JSONSchema{Ref: ref}
+	default:
+		return nil, fmt.Errorf("unsupported type '%s' within config", t)
+	}
+
+	applyRules(s, t, outer)
+	return s, nil
+}
+
+// define adds the schema of a struct to the set of definitions, returning a
+// reference to it. A struct is only ever defined once
+func (g *schemaGenerator) define(t reflect.Type, anchor string) (string, error) {
+	ref := "#/definitions/" + t.Name()
+	if _, ok := g.definitions.Get(t.Name()); ok {
+		return ref, nil
+	}
+
+	// Reserve the definition, allowing a struct to reference itself
+	g.definitions.Set(t.Name(), &JSONSchema{})
+
+	s, err := g.object(t, anchor)
+	if err != nil {
+		return "", err
+	}
+
+	if desc, ok := stringShorthands[t]; ok {
+		s = &JSONSchema{
+			AnyOf: []*JSONSchema{
+				{Description: desc, Type: "string", MinLength: intPtr(1)},
+				s,
+			},
+		}
+	}
+
+	g.definitions.Set(t.Name(), s)
+	return ref, nil
+}
+
+type validateRule struct {
+	tag   string
+	param string
+}
+
+// splitValidateTag parses a validate tag into the rules applied to a field,
+// and those applied to each of its items following a dive
+func splitValidateTag(tag string) ([]validateRule, []validateRule) {
+	if tag == "" {
+		return nil, nil
+	}
+
+	var outer, inner []validateRule
+	dived := false
+	for _, r := range strings.Split(tag, ",") {
+		if r == "dive" && !dived {
+			dived = true
+			continue
+		}
+
+		name, param, _ := strings.Cut(r, "=")
+		rule := validateRule{tag: name, param: param}
+		if dived {
+			inner = append(inner, rule)
+		} else {
+			outer = append(outer, rule)
+		}
+	}
+	return outer, inner
+}
+
+func joinRules(rules []validateRule) string {
+	tags := make([]string, 0, len(rules))
+	for _, r := range rules {
+		if r.param != "" {
+			tags = append(tags, r.tag+"="+r.param)
+		} else {
+			tags = append(tags, r.tag)
+		}
+	}
+	return strings.Join(tags, ",")
+}
+
+// applyRules converts any validation rules into their equivalent JSON Schema
+// keywords. Rules that compare fields are applied to the parent object
+func applyRules(s *JSONSchema, t reflect.Type, rules []validateRule) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for _, r := range rules {
+		switch r.tag {
+		case "min":
+			n, err := strconv.Atoi(r.param)
+			if err != nil {
+				continue
+			}
+
+			switch {
+			case t.Kind() == reflect.Slice:
+				if n > 0 {
+					s.MinItems = intPtr(n)
+				}
+			case t == durationType:
+			case t.Kind() == reflect.String:
+				s.MinLength = intPtr(n)
+			case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+				s.Minimum = intPtr(n)
+			}
+		case "len":
+			if n, err := strconv.Atoi(r.param); err == nil && t.Kind() == reflect.String {
+				s.MinLength = intPtr(n)
+				s.MaxLength = intPtr(n)
+			}
+		case "oneof":
+			s.Enum = strings.Fields(r.param)
+		case "hexadecimal":
+			s.Pattern = "^[a-fA-F0-9]+$"
+		case "url":
+			s.Format = "uri"
+		case "email":
+			s.Format = "email"
+		}
+	}
+}
+
+// implicitlyRequired identifies a field that must be provided, as its zero
+// value would fail validation. Validation of an empty field is skipped when it
+// is optional or only conditionally required
+func implicitlyRequired(t reflect.Type, rules []validateRule) bool {
+	if t.Kind() != reflect.String {
+		return false
+	}
+
+	for _, r := range rules {
+		if r.tag == "omitempty" || r.tag == "required" || strings.HasPrefix(r.tag, "required_") {
+			return false
+		}
+	}
+
+	for _, r := range rules {
+		switch r.tag {
+		case "min", "len":
+			if n, err := strconv.Atoi(r.param); err == nil && n > 0 {
+				return true
+			}
+		case "url", "email", "file", "hexadecimal":
+			return true
+		}
+	}
+	return false
+}
+
+func anyRequired(names []string) *JSONSchema {
+	s := &JSONSchema{}
+	for _, n := range names {
+		s.AnyOf = append(s.AnyOf, &JSONSchema{Required: []string{n}})
+	}
+	return s
+}
+
+func sortedKey(names []string) string {
+	sorted := slices.Clone(names)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+func singleProperty(name string, s *JSONSchema) *SchemaMap {
+	m := &SchemaMap{}
+	m.Set(name, s)
+	return m
+}
+
+func propertyName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+func intPtr(n int) *int {
+	return &n
+}
@@ -0,0 +1,130 @@
+package config
+
+// The description of every field within the config, keyed by the name of its
+// struct and its yaml tag. Each is included within the generated JSON Schema
+var schemaDescriptions = map[string]string{
+	"Uplift.extends":       "A list of base configs to extend, from either a local path or an HTTPS URL. Base configs are merged in order, before this config",
+	"Uplift.annotatedTags": "Use annotated tags instead of lightweight tags when tagging a new semantic version. An annotated tag is treated like a regular commit by git and contains both author details and a commit message. Uplift will either use its defaults or the custom commit details provided when generating the annotated tag",
+	"Uplift.bumps":         "Define a series of files whose semantic version will be bumped. Supports both Regex and JSON Path based file bumps",
+	"Uplift.commitAuthor":  "Changes the commit author used by Uplift when committing any staged changes. Defaults to the Uplift Bot: uplift-bot <uplift@gembaadvantage.com>",
+	"Uplift.commitMessage": "Change the default commit message used by Uplift when committing any staged changes. The default commit message is: ci(uplift): uplifted for version v0.1.0",
+	"Uplift.changelog":     "Customise how Uplift creates and updates a changelog within the repository",
+	"Uplift.git":           "Customise how Uplift interacts with Git",
+	"Uplift.gitea":         "Configure SCM detection and support for Gitea",
+	"Uplift.github":        "Configure SCM detection and support for GitHub",
+	"Uplift.gitlab":        "Configure SCM detection and support for GitLab",
+	"Uplift.hooks":         "Extend Uplift through the use of hooks. A hook is a specific point during a workflow where Uplift executes adhoc shell commands and scripts",
+	"Uplift.pipelines":     "Customise the tasks executed by the bump, changelog, release and tag commands",
+	"Uplift.plugins":       "A list of external executables invoked at an entry point of a workflow. Each plugin is given details about the release as JSON and can respond with changes to apply to the release",
+	"Uplift.tag":           "Customise how Uplift tags the repository",
+	"Uplift.release":       "Customise how Uplift publishes a release",
+	"Uplift.signing":       "Customise how Uplift manages imported signing keys",
+	"Uplift.summary":       "Write a summary of the release once the workflow completes, for use by any later jobs within a CI pipeline",
+	"Uplift.verify":        "Customise how Uplift verifies the integrity of a release",
+	"Uplift.env":           "Define a set of environment variables that are made available to all hooks. Supports loading environment variables from DotEnv (.env) files. Environment variables are merged with system wide ones",
+
+	"Bump.file":  "The path of the file relative to where Uplift is executed. Glob patterns can be used to match multiple files at the same time. Glob syntax is based on https://github.com/goreleaser/fileglob",
+	"Bump.regex": "A regex matcher to be used when bumping the file. Multiple regex matches are supported. Each will be carried out in the order they are defined here. All matches must succeed for the file to be bumped",
+	"Bump.json":  "A JSON path matcher to be used when bumping the file. Multiple path matches are supported. Each will be carried out in the order they are defined here. All matches must succeed for the file to be bumped. JSON path syntax is based on https://github.com/tidwall/sjson",
+
+	"RegexBump.pattern": "A regex pattern for matching and replacing the version within the file",
+	"RegexBump.count":   "The number of times any matched version should be replaced",
+	"RegexBump.semver":  "A flag controlling if the matched version in the file should be replaced with a semantic version. This will strip any 'v' prefix if needed",
+
+	"JSONBump.path":   "A JSON path for matching and replacing the version within the file",
+	"JSONBump.semver": "A flag controlling if the matched version in the file should be replaced with a semantic version. This will strip any 'v' prefix if needed",
+
+	"CommitAuthor.name":  "Name of the commit author",
+	"CommitAuthor.email": "Email of the commit author",
+
+	"Changelog.sort":           "Change the sort order of the commits within each changelog entry. Supported values are [asc, desc, ASC or DESC]. Defaults to desc (descending order) to mirror the default behaviour of 'git log'",
+	"Changelog.exclude":        "A list of commits to exclude during the creation of a changelog. Provide a list of regular expressions for matching commits that are to be excluded. Auto-generated commits from Uplift (with the prefix ci(uplift)) will always be excluded",
+	"Changelog.include":        "A list of commits to cherry-pick and include during the creation of a changelog. Provide a list of regular expressions for matching commits that are to be included",
+	"Changelog.multiline":      "Include multiline commit messages within the changelog. Disables default behaviour of truncating a commit message to its first line",
+	"Changelog.trimHeader":     "Trims any lines preceding the conventional commit type in the commit message",
+	"Changelog.skipPrerelease": "Skips generating a changelog for any prerelease. All commits from a prerelease will be appended to the changelog entry for the next release",
+
+	"Git.atomicPush":       "Push the release commit and tag to the remote within a single atomic push. Either both references are updated on the remote or neither are. As push options are sent once per push, the release will fail if any push option sets skipBranch or skipTag while pushing both the commit and tag. Defaults to false",
+	"Git.ignoreDetached":   "A flag for suppressing the git detached HEAD repository check. If set to true, Uplift will report a warning while running, otherwise Uplift will raise an error and stop. Defaults to false",
+	"Git.ignoreShallow":    "A flag for suppressing the git shallow repository check. If set to true, Uplift will report a warning while running, otherwise Uplift will raise an error and stop. Defaults to false",
+	"Git.pushOptions":      "An array of Git push options that can be independently configured for both branch and tag operations within Uplift. Provided options will be filtered accordingly and appended to the git push operation through the use of the --push-option flag as documented in https://git-scm.com/docs/git-push#Documentation/git-push.txt",
+	"Git.includeArtifacts": "Defines a list of files that uplift will ignore when checking the status of the current repository. If a change is detected that is not defined in this list, uplift will assume its default behaviour and fail due to the repository being in a dirty state",
+	"Git.remoteAhead":      "Defines how Uplift behaves if the remote branch is ahead of the local branch when pushing the release commit",
+
+	"GitRemoteAhead.strategy": "The strategy to apply when the remote is ahead. Either fail the release or rebase the release commit onto the remote branch and retry the push. Any commits pulled in by a rebase are not included within the calculated version or changelog. Defaults to fail",
+	"GitRemoteAhead.retries":  "The maximum number of times the release commit will be rebased before the release fails. Only used by the rebase strategy. Defaults to 3",
+
+	"GitPushOption.option":     "A push option that will be appended to a git push operation within Uplift",
+	"GitPushOption.skipBranch": "A flag to control the exclusion of the current push option from any branch based git push operation",
+	"GitPushOption.skipTag":    "A flag to control the exclusion of the current push option from any tag based git push operation",
+
+	"Gitea.url": "The URL of the self-hosted instance of Gitea. Only the scheme and hostname are required. The hostname is used when matching against the configured remote origin of the cloned repository",
+
+	"GitHub.url": "The URL of the enterprise instance of GitHub. Only the scheme and hostname are required. The hostname is used when matching against the configured remote origin of the cloned repository",
+
+	"GitLab.url": "The URL of the self-managed instance of GitLab. Only the scheme and hostname are required. The hostname is used when matching against the configured remote origin of the cloned repository",
+
+	"Signing.keepKeys": "Keep any imported GPG or SSH signing key, along with the git config that enables signing, once Uplift completes. By default, all imported keys are removed and the git config is restored. A kept GPG key can only be used by exporting GNUPGHOME as the directory that Uplift logs. Defaults to false",
+
+	"Verify.signatures": "Verify the signature of every commit within a release",
+
+	"VerifySignatures.enabled":     "Verify that every commit between the last tag and HEAD has a valid GPG or SSH signature. Defaults to false",
+	"VerifySignatures.allowedKeys": "A list of keys that are allowed to sign commits. Either a GPG fingerprint, a long GPG key ID or an SSH SHA256 fingerprint. If empty, any valid signature will be accepted",
+
+	"Tag.sign": "Sign the tag using an imported GPG key or SSH signing key. A signed tag is always annotated. Defaults to false",
+
+	"Release.mode":         "How a release is published. Either by pushing directly to the current branch, or by opening a pull request from a dedicated release branch. Defaults to push",
+	"Release.branchPrefix": "The prefix of the release branch created when publishing a release through a pull request. The next semantic version is appended to this prefix. Defaults to uplift/release-",
+
+	"Hook.if":              "A condition that must evaluate to true for the hook to execute. Written as a Go template expression against the release details, e.g. eq .Increment \"Major\"",
+	"Hook.cmd":             "A shell command or script to execute. Cannot be used with argv",
+	"Hook.argv":            "A command and its arguments, executed directly without any shell interpretation. Cannot be used with cmd",
+	"Hook.dir":             "The working directory of the hook. Defaults to the current working directory",
+	"Hook.env":             "A set of environment variables only available to this hook. Supports loading environment variables from DotEnv (.env) files",
+	"Hook.timeout":         "The maximum duration of the hook before it is terminated, e.g. 30s or 5m. Defaults to no timeout",
+	"Hook.continueOnError": "Continue executing any remaining hooks if this hook fails. Defaults to false",
+	"Hook.output":          "Where the output of the hook is written. By default, output is only shown when running with --debug",
+	"Hook.outputFile":      "The path of a file any output will be appended to. Required when output is set to file",
+	"Hook.hooks":           "A group of hooks. Cannot be used with cmd or argv",
+	"Hook.parallel":        "Execute a group of hooks in parallel, with any errors reported once all hooks complete. Defaults to false",
+	"Hook.concurrency":     "The maximum number of hooks within a parallel group to execute at once. Defaults to no limit",
+
+	"Hooks.before":          "A list of shell commands or scripts to execute before Uplift runs tasks within any workflow. Either a list of hooks, or a single group of hooks",
+	"Hooks.beforeBump":      "A list of shell commands or scripts to execute before Uplift bumps any configured file. Either a list of hooks, or a single group of hooks",
+	"Hooks.beforeTag":       "A list of shell commands or scripts to execute before Uplift tags the repository with the next semantic release. Either a list of hooks, or a single group of hooks",
+	"Hooks.beforeChangelog": "A list of shell commands or scripts to execute before Uplift runs its changelog generation task. Either a list of hooks, or a single group of hooks",
+	"Hooks.after":           "A list of shell commands or scripts to execute after Uplift completes all tasks within any workflow. Either a list of hooks, or a single group of hooks",
+	"Hooks.afterBump":       "A list of shell commands or scripts to execute after Uplift bumps all configured files. Either a list of hooks, or a single group of hooks",
+	"Hooks.afterTag":        "A list of shell commands or scripts to execute after Uplift tags the repository with the next semantic release. Either a list of hooks, or a single group of hooks",
+	"Hooks.afterChangelog":  "A list of shell commands or scripts to execute after Uplift generates or updates a changelog. Either a list of hooks, or a single group of hooks",
+	"Hooks.onError":         "A list of shell commands or scripts to execute when any task within a workflow fails. Details about the failing task are available through UPLIFT_FAILED_TASK and UPLIFT_ERROR. Either a list of hooks, or a single group of hooks",
+	"Hooks.always":          "A list of shell commands or scripts to execute once a workflow completes, regardless of whether it succeeded or failed. Either a list of hooks, or a single group of hooks",
+
+	"Plugin.name":    "A unique name for the plugin",
+	"Plugin.cmd":     "The path of the executable to invoke. Executed directly without any shell interpretation",
+	"Plugin.args":    "A list of arguments passed to the executable",
+	"Plugin.stage":   "The entry point at which the plugin is executed. A plugin without a stage is only executed when inserted into a pipeline as plugin:<name>",
+	"Plugin.timeout": "The maximum duration of the plugin before it is terminated, e.g. 30s or 5m. Defaults to no timeout",
+	"Plugin.with":    "Any configuration passed to the plugin, as part of its request",
+
+	"Pipelines.bump":          "Customise the tasks executed by the bump command",
+	"Pipelines.changelog":     "Customise the tasks executed by the changelog command",
+	"Pipelines.changelogDiff": "Customise the tasks executed by the changelog command when only outputting a diff (--diff-only). Steps that modify the repository are not supported",
+	"Pipelines.release":       "Customise the tasks executed by the release command",
+	"Pipelines.tag":           "Customise the tasks executed by the tag command",
+
+	"Pipeline.steps":   "Replace the default tasks of the command entirely, allowing them to be reordered",
+	"Pipeline.disable": "A list of steps to remove from the pipeline",
+	"Pipeline.insert":  "A list of steps to insert into the pipeline, either before or after an existing step",
+
+	"PipelineInsert.step":   "The name of the step to insert",
+	"PipelineInsert.after":  "Insert the step after this step. Cannot be used with before",
+	"PipelineInsert.before": "Insert the step before this step. Cannot be used with after",
+
+	"Summary.github":   "Write the summary to $GITHUB_OUTPUT and $GITHUB_STEP_SUMMARY, if either environment variable is present. Defaults to false",
+	"Summary.dotenv":   "Write the summary to a dotenv file, usable as a GitLab artifacts:reports:dotenv report. Either true or the path of the file. Defaults to uplift-release.env",
+	"Summary.manifest": "Write the summary to a JSON manifest. Either true or the path of the file. Defaults to uplift-release.json",
+
+	"Extend.from":   "The local path or HTTPS URL of a base config",
+	"Extend.sha256": "Pin a base config to the SHA256 hash of its contents",
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schemaFile = "../../docs/static/schema.json"
+
+func TestGenerateSchema_UpToDate(t *testing.T) {
+	schema, err := GenerateSchema()
+	require.NoError(t, err)
+
+	committed, err := os.ReadFile(schemaFile)
+	require.NoError(t, err)
+
+	assert.Equal(t, string(committed), string(schema),
+		"docs/static/schema.json is stale, regenerate it using: task schema")
+}
+
+func TestGenerateSchema_MissingDescription(t *testing.T) {
+	desc := schemaDescriptions["Bump.file"]
+	delete(schemaDescriptions, "Bump.file")
+	t.Cleanup(func() { schemaDescriptions["Bump.file"] = desc })
+
+	_, err := GenerateSchema()
+	require.EqualError(t, err, "missing schema description for field 'Bump.file'")
+}
+
+func TestGenerateSchema_NoUnusedDescriptions(t *testing.T) {
+	schema := generateSchema(t)
+
+	for key := range schemaDescriptions {
+		typ, field, _ := strings.Cut(key, ".")
+
+		def := schema
+		if typ != "Uplift" {
+			def = definition(t, schema, typ)
+		}
+
+		_, ok := properties(def)[field]
+		assert.True(t, ok, "description '%s' does not match a field within the config", key)
+	}
+}
+
+func TestGenerateSchema_StringShorthand(t *testing.T) {
+	schema := generateSchema(t)
+
+	opt := definition(t, schema, "GitPushOption")
+	require.Len(t, opt["anyOf"], 2)
+
+	anyOf := opt["anyOf"].([]interface{})
+	assert.Equal(t, "string", anyOf[0].(map[string]interface{})["type"])
+	assert.Equal(t, "object", anyOf[1].(map[string]interface{})["type"])
+	assert.Equal(t, []interface{}{"option"}, anyOf[1].(map[string]interface{})["required"])
+}
+
+func TestGenerateSchema_ValidateTags(t *testing.T) {
+	schema := generateSchema(t)
+
+	git := properties(definition(t, schema, "Git"))
+	artifacts := git["includeArtifacts"].(map[string]interface{})
+	assert.Equal(t, "array", artifacts["type"])
+	assert.NotEmpty(t, artifacts["description"])
+	assert.Equal(t, float64(1), artifacts["items"].(map[string]interface{})["minLength"])
+
+	remote := properties(definition(t, schema, "GitRemoteAhead"))
+	assert.Equal(t, []interface{}{"fail", "rebase"}, remote["strategy"].(map[string]interface{})["enum"])
+	assert.Equal(t, float64(1), remote["retries"].(map[string]interface{})["minimum"])
+
+	plugin := definition(t, schema, "Plugin")
+	assert.Equal(t, []interface{}{"name", "cmd"}, plugin["required"])
+}
+
+func TestGenerateSchema_Deprecated(t *testing.T) {
+	orig := schemaDescriptions["Uplift.annotatedTags"]
+	schemaDescriptions["Uplift.annotatedTags"] = "Deprecated, no longer supported"
+	t.Cleanup(func() { schemaDescriptions["Uplift.annotatedTags"] = orig })
+
+	schema := generateSchema(t)
+
+	annotated := properties(schema)["annotatedTags"].(map[string]interface{})
+	assert.Equal(t, true, annotated["deprecated"])
+}
+
+func TestGenerateSchema_NotDeprecated(t *testing.T) {
+	schema := generateSchema(t)
+
+	annotated := properties(schema)["annotatedTags"].(map[string]interface{})
+	assert.NotContains(t, annotated, "deprecated")
+}
+
+func generateSchema(t *testing.T) map[string]interface{} {
+	t.Helper()
+
+	data, err := GenerateSchema()
+	require.NoError(t, err)
+
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &schema))
+	return schema
+}
+
+func definition(t *testing.T, schema map[string]interface{}, name string) map[string]interface{} {
+	t.Helper()
+
+	defs := schema["definitions"].(map[string]interface{})
+	def, ok := defs[name]
+	require.True(t, ok, "missing definition %s", name)
+	return def.(map[string]interface{})
+}
+
+// properties of an object schema, including one that can be written as a
+// string shorthand
+func properties(schema map[string]interface{}) map[string]interface{} {
+	if props, ok := schema["properties"]; ok {
+		return props.(map[string]interface{})
+	}
+
+	for _, s := range schema["anyOf"].([]interface{}) {
+		if props, ok := s.(map[string]interface{})["properties"]; ok {
+			return props.(map[string]interface{})
+		}
+	}
+	return nil
+}
@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io"
-	"os"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"gopkg.in/yaml.v3"
@@ -14,6 +14,7 @@ import (
 
 // Uplift defines the root configuration of the application
 type Uplift struct {
+	Extends       ExtendList    `yaml:"extends" validate:"dive"`
 	AnnotatedTags bool          `yaml:"annotatedTags"`
 	Bumps         []Bump        `yaml:"bumps" validate:"omitempty,dive"`
 	CommitAuthor  *CommitAuthor `yaml:"commitAuthor" validate:"omitempty"`
@@ -24,9 +25,76 @@ type Uplift struct {
 	GitHub        *GitHub       `yaml:"github" validate:"omitempty"`
 	GitLab        *GitLab       `yaml:"gitlab" validate:"omitempty"`
 	Hooks         *Hooks        `yaml:"hooks" validate:"omitempty"`
+	Pipelines     *Pipelines    `yaml:"pipelines" validate:"omitempty"`
+	Plugins       []Plugin      `yaml:"plugins" validate:"omitempty,unique=Name,dive"`
+	Release       *Release      `yaml:"release" validate:"omitempty"`
+	Signing       *Signing      `yaml:"signing" validate:"omitempty"`
+	Summary       *Summary      `yaml:"summary" validate:"omitempty"`
+	Tag           *Tag          `yaml:"tag" validate:"omitempty"`
+	Verify        *Verify       `yaml:"verify" validate:"omitempty"`
 	Env           []string      `yaml:"env" validate:"dive,min=1"`
 }
 
+// ExtendList defines the base configs extended by a config. A list can either
+// be defined as a sequence, or as a single base config
+type ExtendList []Extend
+
+// UnmarshalYAML defines a custom YAML unmarshal for a [config.ExtendList]
+func (l *ExtendList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var extends []Extend
+	err := unmarshal(&extends)
+	if err == nil {
+		*l = extends
+		return nil
+	}
+
+	// Report why the sequence is invalid, rather than it not being a single item
+	if isSequence(unmarshal) {
+		return err
+	}
+
+	var ext Extend
+	if err := unmarshal(&ext); err != nil {
+		return err
+	}
+
+	*l = ExtendList{ext}
+	return nil
+}
+
+// isSequence identifies if the value being unmarshalled is a YAML sequence
+func isSequence(unmarshal func(interface{}) error) bool {
+	var seq []interface{}
+	return unmarshal(&seq) == nil
+}
+
+// Extend defines a base config, from either a local path or an HTTPS URL.
+// A base config fetched from a URL can be pinned to the SHA256 hash of its
+// contents, guaranteeing its integrity
+type Extend struct {
+	From   string `yaml:"from" validate:"required"`
+	SHA256 string `yaml:"sha256" validate:"omitempty,len=64,hexadecimal"`
+}
+
+type extend Extend
+
+// UnmarshalYAML defines a custom YAML unmarshal for a [config.Extend]
+func (e *Extend) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err == nil {
+		e.From = str
+		return nil
+	}
+
+	var ext extend
+	if err := unmarshal(&ext); err != nil {
+		return err
+	}
+
+	*e = Extend(ext)
+	return nil
+}
+
 // Bump defines configuration for bumping individual files based
 // on the new calculated semantic version number
 type Bump struct {
@@ -70,10 +138,20 @@ type Changelog struct {
 
 // Git defines configuration for how uplift interacts with git
 type Git struct {
+	AtomicPush       bool            `yaml:"atomicPush"`
 	IgnoreDetached   bool            `yaml:"ignoreDetached"`
 	IgnoreShallow    bool            `yaml:"ignoreShallow"`
 	PushOptions      []GitPushOption `yaml:"pushOptions" validate:"dive"`
 	IncludeArtifacts []string        `yaml:"includeArtifacts" validate:"omitempty,dive,min=1,file"`
+	RemoteAhead      *GitRemoteAhead `yaml:"remoteAhead"`
+}
+
+// GitRemoteAhead defines how uplift should behave when the remote branch
+// contains commits that are not within the local branch, before the
+// release commit is pushed
+type GitRemoteAhead struct {
+	Strategy string `yaml:"strategy" validate:"omitempty,oneof=fail rebase"`
+	Retries  int    `yaml:"retries" validate:"omitempty,min=1"`
 }
 
 // GitPushOption provides a way of supplying additional options to
@@ -122,40 +200,229 @@ type GitLab struct {
 	URL string `yaml:"url" validate:"url"`
 }
 
+// Release defines configuration for how a release is published
+type Release struct {
+	Mode         string `yaml:"mode" validate:"omitempty,oneof=push pull-request"`
+	BranchPrefix string `yaml:"branchPrefix"`
+}
+
+// Signing defines configuration for managing any imported signing keys
+type Signing struct {
+	KeepKeys bool `yaml:"keepKeys"`
+}
+
+// Summary defines configuration for writing a summary of a release, for use
+// by any later jobs within a CI pipeline
+type Summary struct {
+	GitHub   bool        `yaml:"github"`
+	DotEnv   SummaryFile `yaml:"dotenv"`
+	Manifest SummaryFile `yaml:"manifest"`
+}
+
+// SummaryFile defines a file that a release summary is written to. It can
+// either be enabled using its default path, or by providing a custom path
+type SummaryFile struct {
+	Enabled bool
+	Path    string
+}
+
+// UnmarshalYAML defines a custom YAML unmarshal for a [config.SummaryFile]
+func (f *SummaryFile) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var enabled bool
+	if err := unmarshal(&enabled); err == nil {
+		f.Enabled = enabled
+		return nil
+	}
+
+	var path string
+	if err := unmarshal(&path); err != nil {
+		return err
+	}
+
+	f.Enabled = path != ""
+	f.Path = path
+	return nil
+}
+
+// Tag defines configuration for how the repository is tagged
+type Tag struct {
+	Sign bool `yaml:"sign"`
+}
+
+// Verify defines configuration for verifying the integrity of a release
+type Verify struct {
+	Signatures *VerifySignatures `yaml:"signatures" validate:"omitempty"`
+}
+
+// VerifySignatures defines configuration for verifying that every commit
+// within a release has been signed by an allowed key
+type VerifySignatures struct {
+	Enabled     bool     `yaml:"enabled"`
+	AllowedKeys []string `yaml:"allowedKeys" validate:"dive,min=1"`
+}
+
 // Hooks define custom configuration for entry points before any uplift
 // workflow. These entry points can be used to execute any custom shell
-// commands or scripts
+// commands or scripts. The onError and always entry points are executed
+// when a workflow fails and once a workflow completes respectively
 type Hooks struct {
-	Before          []string `yaml:"before" validate:"dive,min=1"`
-	BeforeBump      []string `yaml:"beforeBump" validate:"dive,min=1"`
-	BeforeTag       []string `yaml:"beforeTag" validate:"dive,min=1"`
-	BeforeChangelog []string `yaml:"beforeChangelog" validate:"dive,min=1"`
-	After           []string `yaml:"after" validate:"dive,min=1"`
-	AfterBump       []string `yaml:"afterBump" validate:"dive,min=1"`
-	AfterTag        []string `yaml:"afterTag" validate:"dive,min=1"`
-	AfterChangelog  []string `yaml:"afterChangelog" validate:"dive,min=1"`
+	Before          HookList `yaml:"before" validate:"dive"`
+	BeforeBump      HookList `yaml:"beforeBump" validate:"dive"`
+	BeforeTag       HookList `yaml:"beforeTag" validate:"dive"`
+	BeforeChangelog HookList `yaml:"beforeChangelog" validate:"dive"`
+	After           HookList `yaml:"after" validate:"dive"`
+	AfterBump       HookList `yaml:"afterBump" validate:"dive"`
+	AfterTag        HookList `yaml:"afterTag" validate:"dive"`
+	AfterChangelog  HookList `yaml:"afterChangelog" validate:"dive"`
+	OnError         HookList `yaml:"onError" validate:"dive"`
+	Always          HookList `yaml:"always" validate:"dive"`
 }
 
-// Load the YAML config file
-func Load(f string) (Uplift, error) {
-	fh, err := os.Open(f)
-	if err != nil {
-		return Uplift{}, err
+// HookList defines the hooks executed at an entry point. A list can either be
+// defined as a sequence of hooks, or as a single group of hooks, which allows
+// the entire list to be executed in parallel
+type HookList []Hook
+
+// UnmarshalYAML defines a custom YAML unmarshal for a [config.HookList]
+func (l *HookList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var hooks []Hook
+	err := unmarshal(&hooks)
+	if err == nil {
+		*l = hooks
+		return nil
+	}
+
+	// Report why the sequence is invalid, rather than it not being a single group
+	if isSequence(unmarshal) {
+		return err
+	}
+
+	var group Hook
+	if err := unmarshal(&group); err != nil {
+		return err
+	}
+
+	*l = HookList{group}
+	return nil
+}
+
+// Hook defines a single command or script executed at an entry point. A
+// command is interpreted by a shell, while argv is executed directly
+// without any shell interpretation. A hook can also define a group of
+// hooks, optionally executed in parallel. A hook is only executed if its
+// condition is met
+type Hook struct {
+	If              string        `yaml:"if"`
+	Cmd             string        `yaml:"cmd" validate:"required_without_all=Argv Hooks,excluded_with=Argv Hooks"`
+	Argv            []string      `yaml:"argv" validate:"excluded_with=Hooks,omitempty,dive,min=1"`
+	Dir             string        `yaml:"dir"`
+	Env             []string      `yaml:"env" validate:"dive,min=1"`
+	Timeout         time.Duration `yaml:"timeout" validate:"min=0"`
+	ContinueOnError bool          `yaml:"continueOnError"`
+	Output          string        `yaml:"output" validate:"omitempty,oneof=show hide file"`
+	OutputFile      string        `yaml:"outputFile" validate:"required_if=Output file"`
+	Hooks           []Hook        `yaml:"hooks" validate:"dive"`
+	Parallel        bool          `yaml:"parallel"`
+	Concurrency     int           `yaml:"concurrency" validate:"min=0"`
+}
+
+type hook Hook
+
+// UnmarshalYAML defines a custom YAML unmarshal for a [config.Hook]
+func (h *Hook) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err == nil {
+		h.Cmd = str
+		return nil
+	}
+
+	var hk hook
+	if err := unmarshal(&hk); err != nil {
+		return err
+	}
+
+	*h = Hook(hk)
+	return nil
+}
+
+// String returns the command executed by the hook
+func (h Hook) String() string {
+	if len(h.Hooks) > 0 {
+		mode := "sequential"
+		if h.Parallel {
+			mode = "parallel"
+		}
+		return fmt.Sprintf("%s group of %d hooks", mode, len(h.Hooks))
 	}
-	defer fh.Close()
 
-	// Read the contents of the file in one go
-	data, err := io.ReadAll(fh)
+	if len(h.Argv) > 0 {
+		return strings.Join(h.Argv, " ")
+	}
+	return h.Cmd
+}
+
+// Pipelines defines configuration for customising the tasks executed by
+// each command
+type Pipelines struct {
+	Bump          *Pipeline `yaml:"bump" validate:"omitempty"`
+	Changelog     *Pipeline `yaml:"changelog" validate:"omitempty"`
+	ChangelogDiff *Pipeline `yaml:"changelogDiff" validate:"omitempty"`
+	Release       *Pipeline `yaml:"release" validate:"omitempty"`
+	Tag           *Pipeline `yaml:"tag" validate:"omitempty"`
+}
+
+// Pipeline defines how the default tasks of a command are customised. Steps
+// replace the default tasks entirely, while any disabled or inserted steps
+// are applied afterwards. Every step is referenced by its task name
+type Pipeline struct {
+	Steps   []string         `yaml:"steps" validate:"dive,min=1"`
+	Disable []string         `yaml:"disable" validate:"dive,min=1"`
+	Insert  []PipelineInsert `yaml:"insert" validate:"dive"`
+}
+
+// PipelineInsert defines a step to insert either before or after an
+// existing step within a pipeline
+type PipelineInsert struct {
+	Step   string `yaml:"step" validate:"required"`
+	After  string `yaml:"after" validate:"required_without=Before,excluded_with=Before"`
+	Before string `yaml:"before" validate:"required_without=After,excluded_with=After"`
+}
+
+// Plugin defines an external executable invoked at an entry point of a
+// workflow. Unlike a hook, a plugin is given details about the release as
+// JSON and can respond with changes to apply to the release. A plugin
+// without a stage is only executed when inserted into a pipeline
+type Plugin struct {
+	Name    string                 `yaml:"name" validate:"required"`
+	Cmd     string                 `yaml:"cmd" validate:"required"`
+	Args    []string               `yaml:"args" validate:"dive,min=1"`
+	Stage   string                 `yaml:"stage" validate:"omitempty,oneof=before beforeBump afterBump beforeChangelog afterChangelog beforeTag afterTag after"`
+	Timeout time.Duration          `yaml:"timeout" validate:"min=0"`
+	With    map[string]interface{} `yaml:"with"`
+}
+
+// Load the YAML config file, merging any base configs it extends
+func Load(f string) (Uplift, error) {
+	data, err := Resolve(f)
 	if err != nil {
 		return Uplift{}, err
 	}
 
+	return Parse(data)
+}
+
+// Parse the YAML config, interpolating any environment variables within its
+// values. Any base configs must have already been merged using [Resolve]
+func Parse(data []byte) (Uplift, error) {
 	decoder := yaml.NewDecoder(bytes.NewReader(data))
 	decoder.KnownFields(true)
 
 	var cfg Uplift
-	err = decoder.Decode(&cfg)
+	if err := decoder.Decode(&cfg); err != nil {
+		return cfg, err
+	}
 
+	err := interpolate(&cfg)
 	return cfg, err
 }
 
@@ -165,29 +432,10 @@ func Load(f string) (Uplift, error) {
 func (c Uplift) Validate() error {
 	if err := validator.New().Struct(c); err != nil {
 		var errMsg strings.Builder
-		errMsg.WriteString("uplift configuration contains validation errors. Please fix before proceeding:\n\n")
+		errMsg.WriteString(validationHeader)
 
 		for _, err := range err.(validator.ValidationErrors) {
-			var reason string
-			switch err.ActualTag() {
-			case "min":
-				reason = fmt.Sprintf("contains a value that does not meet the minimum expected length of '%s'\n", err.Param())
-			case "file":
-				reason = fmt.Sprintf("contains a path to a file that does not exist '%v'\n", err.Value())
-			case "url":
-				reason = fmt.Sprintf("contains an invalid url '%s'\n", err.Value())
-			case "email":
-				reason = fmt.Sprintf("contains an invalid email address '%s'\n", err.Value())
-			case "oneof":
-				reason = fmt.Sprintf("contains a value that is not one of the following [%s]\n", err.Param())
-			case "required_without":
-				reason = fmt.Sprintf("must be provided when field '%s' is missing\n", err.Param())
-			case "required_without_all":
-				reason = fmt.Sprintf("must be provided when all other fields [%s] are missing\n", err.Param())
-			}
-
-			errMsg.WriteString(fmt.Sprintf(" field '%s' ", err.Namespace()))
-			errMsg.WriteString(reason)
+			errMsg.WriteString(fmt.Sprintf(" field '%s' %s\n", err.Namespace(), reason(err)))
 		}
 
 		return errors.New(errMsg.String())
@@ -195,3 +443,53 @@ func (c Uplift) Validate() error {
 
 	return nil
 }
+
+const validationHeader = "uplift configuration contains validation errors. Please fix before proceeding:\n\n"
+
+// reason describes why a field failed validation
+func reason(err validator.FieldError) string {
+	return describeRule(err.ActualTag(), err.Param(), err.Kind(), err.Value())
+}
+
+// describeRule describes a failed validation rule, along with the value
+// that failed it. The kind of the value determines how some rules are described
+func describeRule(tag, param string, kind reflect.Kind, value interface{}) string {
+	switch tag {
+	case "required":
+		return "must be provided"
+	case "unique":
+		return fmt.Sprintf("contains duplicate values for field '%s'", param)
+	case "min":
+		switch kind {
+		case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+			return fmt.Sprintf("contains a value that does not meet the minimum expected length of '%s'", param)
+		}
+		return fmt.Sprintf("must be at least '%s'", param)
+	case "len":
+		return fmt.Sprintf("contains a value that does not have the expected length of '%s'", param)
+	case "file":
+		return fmt.Sprintf("contains a path to a file that does not exist '%v'", value)
+	case "url":
+		return fmt.Sprintf("contains an invalid url '%s'", value)
+	case "email":
+		return fmt.Sprintf("contains an invalid email address '%s'", value)
+	case "hexadecimal":
+		return fmt.Sprintf("contains a value that is not hexadecimal '%v'", value)
+	case "oneof":
+		return fmt.Sprintf("contains a value that is not one of the following [%s]", param)
+	case "required_without":
+		return fmt.Sprintf("must be provided when field '%s' is missing", param)
+	case "required_without_all":
+		return fmt.Sprintf("must be provided when all other fields [%s] are missing", param)
+	case "required_if":
+		field, value, _ := strings.Cut(param, " ")
+		return fmt.Sprintf("must be provided when field '%s' is '%s'", field, value)
+	case "excluded_with":
+		return fmt.Sprintf("must not be provided when any of the fields [%s] are set", param)
+	}
+
+	if param != "" {
+		return fmt.Sprintf("failed the validation rule '%s=%s'", tag, param)
+	}
+	return fmt.Sprintf("failed the validation rule '%s'", tag)
+}
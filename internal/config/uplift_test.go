@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,6 +103,111 @@ git:
 	require.Error(t, err)
 }
 
+func TestUnmarshalHook(t *testing.T) {
+	path := WriteFile(t, `
+hooks:
+  before:
+    - echo "hello"
+`)
+
+	cfg, err := Load(path)
+
+	require.NoError(t, err)
+	require.Len(t, cfg.Hooks.Before, 1)
+	assert.Equal(t, Hook{Cmd: `echo "hello"`}, cfg.Hooks.Before[0])
+}
+
+func TestUnmarshalHookComplex(t *testing.T) {
+	path := WriteFile(t, `
+hooks:
+  before:
+    - cmd: ./build.sh
+      dir: scripts
+      env:
+        - KEY=VALUE
+      timeout: 30s
+      continueOnError: true
+      output: file
+      outputFile: build.log
+    - argv: [go, build, ./...]
+      output: hide
+`)
+
+	cfg, err := Load(path)
+
+	require.NoError(t, err)
+	require.Len(t, cfg.Hooks.Before, 2)
+	assert.Equal(t, Hook{
+		Cmd:             "./build.sh",
+		Dir:             "scripts",
+		Env:             []string{"KEY=VALUE"},
+		Timeout:         30 * time.Second,
+		ContinueOnError: true,
+		Output:          "file",
+		OutputFile:      "build.log",
+	}, cfg.Hooks.Before[0])
+	assert.Equal(t, Hook{
+		Argv:   []string{"go", "build", "./..."},
+		Output: "hide",
+	}, cfg.Hooks.Before[1])
+}
+
+func TestUnmarshalHookGroup(t *testing.T) {
+	path := WriteFile(t, `
+hooks:
+  afterTag:
+    - echo "before group"
+    - parallel: true
+      concurrency: 2
+      hooks:
+        - docker push app:1
+        - docker push app:2
+`)
+
+	cfg, err := Load(path)
+
+	require.NoError(t, err)
+	require.Len(t, cfg.Hooks.AfterTag, 2)
+	assert.Equal(t, Hook{
+		Parallel:    true,
+		Concurrency: 2,
+		Hooks: []Hook{
+			{Cmd: "docker push app:1"},
+			{Cmd: "docker push app:2"},
+		},
+	}, cfg.Hooks.AfterTag[1])
+}
+
+func TestUnmarshalHookListAsGroup(t *testing.T) {
+	path := WriteFile(t, `
+hooks:
+  afterTag:
+    parallel: true
+    hooks:
+      - docker push app:1
+      - docker push app:2
+`)
+
+	cfg, err := Load(path)
+
+	require.NoError(t, err)
+	require.Len(t, cfg.Hooks.AfterTag, 1)
+	assert.True(t, cfg.Hooks.AfterTag[0].Parallel)
+	assert.Len(t, cfg.Hooks.AfterTag[0].Hooks, 2)
+}
+
+func TestUnmarshalHookInvalid(t *testing.T) {
+	path := WriteFile(t, `
+hooks:
+  before:
+    - invalid: hook
+`)
+
+	_, err := Load(path)
+
+	require.Error(t, err)
+}
+
 func TestValidateBumpFilePathEmpty(t *testing.T) {
 	cfg := Uplift{
 		Bumps: []Bump{
@@ -179,7 +285,7 @@ func TestValidateRegexBumpCountLessThanZero(t *testing.T) {
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Bumps[0].Regex[0].Count' contains a value that does not meet the minimum expected length of '0'")
+	require.ErrorContains(t, err, "field 'Uplift.Bumps[0].Regex[0].Count' must be at least '0'")
 }
 
 func TestValidateJsonBumpPathEmpty(t *testing.T) {
@@ -353,87 +459,195 @@ func TestValidateGiteaNonCompliantURL(t *testing.T) {
 func TestValidateHooksBeforeEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			Before: []string{""},
+			Before: []Hook{{}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.Before[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.Before[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
 }
 
 func TestValidateHooksBeforeBumpEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			BeforeBump: []string{""},
+			BeforeBump: []Hook{{}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.BeforeBump[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.BeforeBump[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
 }
 
 func TestValidateHooksBeforeTagEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			BeforeTag: []string{""},
+			BeforeTag: []Hook{{}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.BeforeTag[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.BeforeTag[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
 }
 
 func TestValidateHooksBeforeChangelogEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			BeforeChangelog: []string{""},
+			BeforeChangelog: []Hook{{}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.BeforeChangelog[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.BeforeChangelog[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
 }
 
 func TestValidateHooksAfterEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			After: []string{""},
+			After: []Hook{{}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.After[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.After[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
 }
 
 func TestValidateHooksAfterBumpEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			AfterBump: []string{""},
+			AfterBump: []Hook{{}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.AfterBump[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.AfterBump[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
 }
 
 func TestValidateHooksAfterTagEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			AfterTag: []string{""},
+			AfterTag: []Hook{{}},
+		},
+	}
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.AfterTag[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
+}
+
+func TestValidateHookCmdWithArgv(t *testing.T) {
+	cfg := Uplift{
+		Hooks: &Hooks{
+			Before: []Hook{{Cmd: "echo", Argv: []string{"echo"}}},
+		},
+	}
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.Before[0].Cmd' must not be provided when any of the fields [Argv Hooks] are set")
+}
+
+func TestValidateHookOutputFileMissing(t *testing.T) {
+	cfg := Uplift{
+		Hooks: &Hooks{
+			Before: []Hook{{Cmd: "echo", Output: "file"}},
+		},
+	}
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.Before[0].OutputFile' must be provided when field 'Output' is 'file'")
+}
+
+func TestValidateHookOutputInvalid(t *testing.T) {
+	cfg := Uplift{
+		Hooks: &Hooks{
+			Before: []Hook{{Cmd: "echo", Output: "stdout"}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.AfterTag[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.Before[0].Output' contains a value that is not one of the following [show hide file]")
 }
 
 func TestValidateHooksAfterChangelogEmpty(t *testing.T) {
 	cfg := Uplift{
 		Hooks: &Hooks{
-			AfterChangelog: []string{""},
+			AfterChangelog: []Hook{{}},
 		},
 	}
 
 	err := cfg.Validate()
-	require.ErrorContains(t, err, "field 'Uplift.Hooks.AfterChangelog[0]' contains a value that does not meet the minimum expected length of '1'")
+	require.ErrorContains(t, err, "field 'Uplift.Hooks.AfterChangelog[0].Cmd' must be provided when all other fields [Argv Hooks] are missing")
+}
+
+func TestValidatePluginMissingFields(t *testing.T) {
+	cfg := Uplift{
+		Plugins: []Plugin{{}},
+	}
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err, "field 'Uplift.Plugins[0].Name' must be provided")
+	require.ErrorContains(t, err, "field 'Uplift.Plugins[0].Cmd' must be provided")
+}
+
+func TestValidatePluginStageUnsupported(t *testing.T) {
+	cfg := Uplift{
+		Plugins: []Plugin{{Name: "publish", Cmd: "publish", Stage: "afterPush"}},
+	}
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err, "field 'Uplift.Plugins[0].Stage' contains a value that is not one of the following [before beforeBump afterBump beforeChangelog afterChangelog beforeTag afterTag after]")
+}
+
+func TestValidatePluginNamesUnique(t *testing.T) {
+	cfg := Uplift{
+		Plugins: []Plugin{
+			{Name: "publish", Cmd: "publish", Stage: "before"},
+			{Name: "publish", Cmd: "publish", Stage: "after"},
+		},
+	}
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err, "field 'Uplift.Plugins' contains duplicate values for field 'Name'")
+}
+
+func TestValidatePipelineInsertPosition(t *testing.T) {
+	cfg := Uplift{
+		Pipelines: &Pipelines{
+			Release: &Pipeline{
+				Insert: []PipelineInsert{
+					{Step: "plugin:publish"},
+					{Step: "plugin:publish", After: "bump", Before: "changelog"},
+				},
+			},
+		},
+	}
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err, "field 'Uplift.Pipelines.Release.Insert[0].After' must be provided when field 'Before' is missing")
+	require.ErrorContains(t, err, "field 'Uplift.Pipelines.Release.Insert[1].After' must not be provided when any of the fields [Before] are set")
+}
+
+func TestUnmarshalSummaryFile(t *testing.T) {
+	path := WriteFile(t, `
+summary:
+  github: true
+  dotenv: true
+  manifest: release/manifest.json
+`)
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.Summary.GitHub)
+	assert.Equal(t, SummaryFile{Enabled: true}, cfg.Summary.DotEnv)
+	assert.Equal(t, SummaryFile{Enabled: true, Path: "release/manifest.json"}, cfg.Summary.Manifest)
+}
+
+func TestUnmarshalSummaryFileInvalid(t *testing.T) {
+	path := WriteFile(t, `
+summary:
+  dotenv:
+    path: release.env
+`)
+
+	_, err := Load(path)
+	require.Error(t, err)
 }
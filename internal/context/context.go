@@ -5,26 +5,34 @@ import (
 	"io"
 
 	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/journal"
 	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/trace"
 	git "github.com/purpleclay/gitz"
 )
 
 // Context provides a way to share common state across tasks
 type Context struct {
 	ctx.Context
+	AtomicPush               bool
 	Changelog                Changelog
+	Cleanup                  []func() error
 	CommitDetails            git.CommitDetails
 	Config                   config.Uplift
 	CurrentVersion           semver.Version
 	IncludeArtifacts         []string
+	KeepSigningKeys          bool
 	DryRun                   bool
 	Debug                    bool
 	FetchTags                bool
 	FilterOnPrerelease       bool
-	GitClient                *git.Client
+	GitClient                *trace.GitClient
 	IgnoreDetached           bool
 	IgnoreExistingPrerelease bool
 	IgnoreShallow            bool
+	Increment                semver.Increment
+	Journal                  *journal.Journal
+	Plan                     *Plan
 	Prerelease               string
 	Metadata                 string
 	NextVersion              semver.Version
@@ -33,11 +41,20 @@ type Context struct {
 	NoPush                   bool
 	NoStage                  bool
 	Out                      io.Writer
+	PendingPush              PendingPush
 	PrintCurrentTag          bool
 	PrintNextTag             bool
+	PullRequest              *PullRequest
+	ReleaseNotes             string
 	SCM                      SCM
+	ShowDiff                 bool
+	SignTags                 bool
 	SkipBumps                bool
 	SkipChangelog            bool
+	TagCommit                string
+	TraceEndpoint            string
+	TraceFile                string
+	VerifySignatures         *VerifySignatures
 }
 
 // SCMProvider is used for identifying the source code management tool used
@@ -57,6 +74,8 @@ type SCM struct {
 	Provider  SCMProvider
 	TagURL    string
 	CommitURL string
+	APIURL    string
+	Repo      string
 }
 
 // Changelog provides details about how the changelog should be managed
@@ -73,22 +92,68 @@ type Changelog struct {
 	TrimHeader     bool
 }
 
+// PendingPush contains all references that have been deferred, ready to
+// be pushed to the remote in a single atomic operation
+type PendingPush struct {
+	Branch string
+	Tag    string
+}
+
+// Plan captures the outcome of a release without writing any changes to the
+// repository. When set, tasks running in dry run mode will record what they
+// would have changed
+type Plan struct {
+	Files     []FileDiff
+	Changelog string
+	Markdown  string
+}
+
+// PullRequest provides details about a release that is published through a
+// pull request, rather than being pushed directly to the current branch
+type PullRequest struct {
+	Base   string
+	Branch string
+	Prefix string
+	Number int
+	URL    string
+}
+
+// VerifySignatures provides details about how the signatures of commits within
+// a release should be verified
+type VerifySignatures struct {
+	AllowedKeys []string
+}
+
+// FileDiff contains a unified diff of a file that would have been changed
+type FileDiff struct {
+	Path string
+	Diff string
+}
+
 // New constructs a context that captures both runtime configuration and
 // user defined runtime options
 func New(cfg config.Uplift, out io.Writer) *Context {
 	// TODO: this should throw an error if required
 	gc, _ := git.NewClient()
 
-	return &Context{
-		Context:   ctx.Background(),
-		Config:    cfg,
-		GitClient: gc,
-		Out:       out,
+	c := &Context{
+		Context: ctx.Background(),
+		Config:  cfg,
+		Out:     out,
 		SCM: SCM{
 			Provider: Unrecognised,
 		},
 		IncludeArtifacts: IncludeArtifacts(cfg),
+		AtomicPush:       AtomicPush(cfg),
+		PullRequest:      PullRequestMode(cfg),
+		SignTags:         SignTags(cfg),
+		KeepSigningKeys:  KeepSigningKeys(cfg),
+		VerifySignatures: VerifySignaturesMode(cfg),
 	}
+
+	// Trace every git operation as a child of the task executing it
+	c.GitClient = trace.NewGitClient(gc, func() ctx.Context { return c.Context })
+	return c
 }
 
 // For nil safe object getting
@@ -99,3 +164,53 @@ func IncludeArtifacts(c config.Uplift) []string {
 
 	return c.Git.IncludeArtifacts
 }
+
+// For nil safe object getting
+func AtomicPush(c config.Uplift) bool {
+	if c.Git == nil {
+		return false
+	}
+
+	return c.Git.AtomicPush
+}
+
+// For nil safe object getting
+func PullRequestMode(c config.Uplift) *PullRequest {
+	if c.Release == nil || c.Release.Mode != "pull-request" {
+		return nil
+	}
+
+	prefix := c.Release.BranchPrefix
+	if prefix == "" {
+		prefix = "uplift/release-"
+	}
+
+	return &PullRequest{Prefix: prefix}
+}
+
+// For nil safe object getting
+func SignTags(c config.Uplift) bool {
+	if c.Tag == nil {
+		return false
+	}
+
+	return c.Tag.Sign
+}
+
+// For nil safe object getting
+func KeepSigningKeys(c config.Uplift) bool {
+	if c.Signing == nil {
+		return false
+	}
+
+	return c.Signing.KeepKeys
+}
+
+// For nil safe object getting
+func VerifySignaturesMode(c config.Uplift) *VerifySignatures {
+	if c.Verify == nil || c.Verify.Signatures == nil || !c.Verify.Signatures.Enabled {
+		return nil
+	}
+
+	return &VerifySignatures{AllowedKeys: c.Verify.Signatures.AllowedKeys}
+}
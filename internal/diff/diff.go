@@ -0,0 +1,222 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ContextLines defines the number of unchanged lines that surround each
+// change within a hunk of a unified diff
+const ContextLines = 3
+
+type opKind int
+
+const (
+	equal opKind = iota
+	insert
+	remove
+)
+
+type op struct {
+	kind opKind
+	line string
+	// Line numbers (1-based) within the before and after content. A value
+	// of zero indicates the line does not exist on that side of the diff
+	before int
+	after  int
+}
+
+// Unified generates a unified diff between two versions of the same file,
+// in the format produced by `diff -u`. An empty string is returned if there
+// are no differences
+func Unified(path, before, after string) string {
+	return render(path, before, after, false)
+}
+
+// Numbered generates a unified diff between two versions of the same file,
+// with each line prefixed by its line number within the before and after
+// versions. Designed for readability within a terminal
+func Numbered(path, before, after string) string {
+	return render(path, before, after, true)
+}
+
+func render(path, before, after string, numbered bool) string {
+	if before == after {
+		return ""
+	}
+
+	ops := lineOps(splitLines(before), splitLines(after))
+
+	width := 0
+	if numbered {
+		n := 0
+		for _, o := range ops {
+			n = max(n, o.before, o.after)
+		}
+		width = len(fmt.Sprintf("%d", n))
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "--- a/%s\n+++ b/%s\n", path, path)
+	for _, h := range hunks(ops) {
+		writeHunk(&buf, ops[h[0]:h[1]], width)
+	}
+
+	return buf.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+
+	return strings.SplitAfter(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// lineOps produces an edit script between the two sets of lines. Any common
+// prefix and suffix is trimmed before calculating the longest common
+// subsequence, which keeps the cost low for typical version bumps that only
+// touch a handful of lines within a large file
+func lineOps(a, b []string) []op {
+	pre := 0
+	for pre < len(a) && pre < len(b) && trimEOL(a[pre]) == trimEOL(b[pre]) {
+		pre++
+	}
+
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre &&
+		trimEOL(a[len(a)-1-suf]) == trimEOL(b[len(b)-1-suf]) {
+		suf++
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	for i := 0; i < pre; i++ {
+		ops = append(ops, op{kind: equal, line: a[i], before: i + 1, after: i + 1})
+	}
+
+	ma := a[pre : len(a)-suf]
+	mb := b[pre : len(b)-suf]
+
+	// Classic LCS table, built from the end so the script can be walked forwards
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if trimEOL(ma[i]) == trimEOL(mb[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case i < len(ma) && j < len(mb) && trimEOL(ma[i]) == trimEOL(mb[j]):
+			ops = append(ops, op{kind: equal, line: mb[j], before: pre + i + 1, after: pre + j + 1})
+			i++
+			j++
+		case i < len(ma) && (j == len(mb) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, op{kind: remove, line: ma[i], before: pre + i + 1})
+			i++
+		default:
+			ops = append(ops, op{kind: insert, line: mb[j], after: pre + j + 1})
+			j++
+		}
+	}
+
+	for k := suf; k > 0; k-- {
+		ops = append(ops, op{
+			kind:   equal,
+			line:   b[len(b)-k],
+			before: len(a) - k + 1,
+			after:  len(b) - k + 1,
+		})
+	}
+
+	return ops
+}
+
+func trimEOL(s string) string {
+	return strings.TrimSuffix(s, "\n")
+}
+
+// hunks groups changes within the edit script into ranges, each padded with
+// the expected number of context lines. Changes that are close enough to
+// share context are merged into a single hunk
+func hunks(ops []op) [][2]int {
+	var ranges [][2]int
+	for i, o := range ops {
+		if o.kind == equal {
+			continue
+		}
+
+		start := max(i-ContextLines, 0)
+		end := min(i+ContextLines+1, len(ops))
+
+		if n := len(ranges); n > 0 && start <= ranges[n-1][1] {
+			ranges[n-1][1] = end
+			continue
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+
+	return ranges
+}
+
+func writeHunk(buf *strings.Builder, ops []op, width int) {
+	var beforeStart, beforeCount, afterStart, afterCount int
+	for _, o := range ops {
+		if o.kind != insert {
+			if beforeStart == 0 {
+				beforeStart = o.before
+			}
+			beforeCount++
+		}
+
+		if o.kind != remove {
+			if afterStart == 0 {
+				afterStart = o.after
+			}
+			afterCount++
+		}
+	}
+
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(beforeStart, beforeCount), hunkRange(afterStart, afterCount))
+	for _, o := range ops {
+		prefix := " "
+		switch o.kind {
+		case insert:
+			prefix = "+"
+		case remove:
+			prefix = "-"
+		}
+
+		if width > 0 {
+			fmt.Fprintf(buf, "%s %s ", lineNo(o.before, width), lineNo(o.after, width))
+		}
+
+		buf.WriteString(prefix)
+		buf.WriteString(trimEOL(o.line))
+		buf.WriteString("\n")
+	}
+}
+
+func lineNo(n, width int) string {
+	if n == 0 {
+		return strings.Repeat(" ", width)
+	}
+
+	return fmt.Sprintf("%*d", width, n)
+}
+
+func hunkRange(start, count int) string {
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+
+	return fmt.Sprintf("%d,%d", start, count)
+}
@@ -0,0 +1,82 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/diff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnified(t *testing.T) {
+	before := `name: uplift
+version: 0.1.0
+appVersion: 0.1.0
+`
+	after := `name: uplift
+version: 0.2.0
+appVersion: 0.2.0
+`
+
+	expected := `--- a/Chart.yaml
++++ b/Chart.yaml
+@@ -1,3 +1,3 @@
+ name: uplift
+-version: 0.1.0
+-appVersion: 0.1.0
++version: 0.2.0
++appVersion: 0.2.0
+`
+	assert.Equal(t, expected, diff.Unified("Chart.yaml", before, after))
+}
+
+func TestUnified_NoChanges(t *testing.T) {
+	assert.Empty(t, diff.Unified("test.txt", "version: 0.1.0", "version: 0.1.0"))
+}
+
+func TestUnified_SeparateHunks(t *testing.T) {
+	before := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+	after := "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n"
+
+	expected := `--- a/test.txt
++++ b/test.txt
+@@ -1,4 +1,4 @@
+-1
++one
+ 2
+ 3
+ 4
+@@ -9,4 +9,4 @@
+ 9
+ 10
+ 11
+-12
++twelve
+`
+	assert.Equal(t, expected, diff.Unified("test.txt", before, after))
+}
+
+func TestUnified_NewFile(t *testing.T) {
+	expected := `--- a/CHANGELOG.md
++++ b/CHANGELOG.md
+@@ -0,0 +1,2 @@
++# Changelog
++
+`
+	assert.Equal(t, expected, diff.Unified("CHANGELOG.md", "", "# Changelog\n\n"))
+}
+
+func TestNumbered(t *testing.T) {
+	before := "1\n2\n3\n4\n5\n6\n7\n8\n9\nversion: 0.1.0\n"
+	after := "1\n2\n3\n4\n5\n6\n7\n8\n9\nversion: 0.2.0\n"
+
+	expected := `--- a/test.txt
++++ b/test.txt
+@@ -7,4 +7,4 @@
+ 7  7  7
+ 8  8  8
+ 9  9  9
+10    -version: 0.1.0
+   10 +version: 0.2.0
+`
+	assert.Equal(t, expected, diff.Numbered("test.txt", before, after))
+}
@@ -0,0 +1,52 @@
+package gitconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gembaadvantage/uplift/internal/trace"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// Snapshot captures the current values of a set of local git config settings,
+// returning a function that restores them. Any setting without a local value
+// when captured is unset when restored. Used to undo any temporary changes to
+// the git config of a repository
+func Snapshot(gc *trace.GitClient, keys ...string) func() error {
+	values := map[string][]string{}
+	for _, key := range keys {
+		values[key] = localValues(gc, key)
+	}
+
+	return func() error {
+		for _, key := range keys {
+			if len(localValues(gc, key)) > 0 {
+				if _, err := gc.Exec("git config --local --unset-all " + key); err != nil {
+					return err
+				}
+			}
+
+			for _, v := range values[key] {
+				quoted, err := syntax.Quote(v, syntax.LangBash)
+				if err != nil {
+					return err
+				}
+
+				if _, err := gc.Exec(fmt.Sprintf("git config --local --add %s %s", key, quoted)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// localValues returns all local values of a git config setting. Git reports
+// an error if the setting does not exist
+func localValues(gc *trace.GitClient, key string) []string {
+	out, err := gc.Exec("git config --local --get-all " + key)
+	if err != nil || out == "" {
+		return nil
+	}
+	return strings.Split(out, "\n")
+}
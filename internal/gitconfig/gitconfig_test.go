@@ -0,0 +1,60 @@
+package gitconfig_test
+
+import (
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/gitconfig"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git config --local user.signingKey ABCDEF")
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	restore := gitconfig.Snapshot(gc, "user.signingKey", "gpg.format")
+	require.NoError(t, gc.ConfigSetL("user.signingKey", "123456", "gpg.format", "ssh"))
+
+	require.NoError(t, restore())
+
+	assert.Equal(t, "ABCDEF", gittest.MustExec(t, "git config --local --get-all user.signingKey"))
+	_, err = gc.Exec("git config --local --get-all gpg.format")
+	assert.Error(t, err)
+}
+
+func TestSnapshot_QuotesValues(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, `git config --local user.name "Joe O'Bloggs \$HOME"`)
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	restore := gitconfig.Snapshot(gc, "user.name")
+	require.NoError(t, gc.ConfigSetL("user.name", "uplift"))
+
+	require.NoError(t, restore())
+
+	assert.Equal(t, "Joe O'Bloggs $HOME", gittest.MustExec(t, "git config --local --get-all user.name"))
+}
+
+func TestSnapshot_Unchanged(t *testing.T) {
+	gittest.InitRepository(t)
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	restore := gitconfig.Snapshot(gc, "gpg.format")
+	require.NoError(t, restore())
+
+	_, err = gc.Exec("git config --local --get-all gpg.format")
+	assert.Error(t, err)
+}
@@ -3,9 +3,9 @@ package gpg
 import (
 	"encoding/base64"
 	"errors"
+	"io"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"regexp"
 	"strings"
 )
@@ -14,10 +14,6 @@ var (
 	secLineRegex = regexp.MustCompile("(?im)^sec.*")
 	uidLineRegex = regexp.MustCompile("(?im)^uid.*")
 	uidRegex     = regexp.MustCompile(`([^\(]*)(\s\(.*\)\s)?<(.*)>`)
-
-	importKeyPath      = filepath.Join(os.TempDir(), "uplift-gpg-import.asc")
-	activateKeyPath    = filepath.Join(os.TempDir(), "uplift-activate-key.txt")
-	activateKeySigPath = filepath.Join(os.TempDir(), "uplift-activate-key.txt.sig")
 )
 
 // KeyDetails contains details about an imported private key
@@ -25,6 +21,10 @@ type KeyDetails struct {
 	ID        string
 	UserName  string
 	UserEmail string
+
+	// Home is the path of the ephemeral GNUPGHOME directory containing the
+	// keyring the key was imported into
+	Home string
 }
 
 // IsInstalled identifies whether gpg is installed under the current $PATH
@@ -34,8 +34,11 @@ func IsInstalled() bool {
 }
 
 // ImportKey attempts to import the private key using the provided passphrase.
-// If importing is successful, the key will automatically be activated ready
-// for use
+// The key is imported into a keyring within an ephemeral GNUPGHOME directory,
+// only accessible by the current user. Both the key and passphrase are passed
+// to gpg over stdin, ensuring neither is written to disk or visible within the
+// process list. If importing is successful, the key will automatically be
+// activated ready for use
 func ImportKey(key, passphrase, fingerprint string) (KeyDetails, error) {
 	if !strings.HasPrefix(key, "--") {
 		// Decode base64 string into expected format
@@ -46,23 +49,26 @@ func ImportKey(key, passphrase, fingerprint string) (KeyDetails, error) {
 		key = string(decoded)
 	}
 
-	if err := os.WriteFile(importKeyPath, []byte(key), 0o600); err != nil {
+	// A temporary directory is always created with 0700 permissions
+	home, err := os.MkdirTemp("", "uplift-gnupg-")
+	if err != nil {
 		return KeyDetails{}, err
 	}
-	defer os.Remove(importKeyPath)
 
-	os.TempDir()
-
-	// Import the key using the temporary file on disk
-	if _, err := Run("--batch", "--import", "--yes", importKeyPath); err != nil {
+	if _, err := runHome(home, strings.NewReader(key), "--batch", "--import", "--yes"); err != nil {
+		RemoveHome(home)
 		return KeyDetails{}, err
 	}
 
-	out, _ := Clean(Run("--batch", "--with-colons", "--list-secret-keys", fingerprint))
+	out, _ := Clean(runHome(home, nil, "--batch", "--with-colons", "--list-secret-keys", fingerprint))
 
 	// Parse the key ID and the user details from the GPG private key
 	sec := secLineRegex.FindString(out)
 	uid := uidLineRegex.FindString(out)
+	if sec == "" || uid == "" {
+		RemoveHome(home)
+		return KeyDetails{}, errors.New("no secret key found with fingerprint " + fingerprint)
+	}
 	uid = strings.Split(uid, ":")[9]
 
 	uidParts := uidRegex.FindStringSubmatch(uid)
@@ -71,32 +77,41 @@ func ImportKey(key, passphrase, fingerprint string) (KeyDetails, error) {
 		ID:        strings.Split(sec, ":")[4],
 		UserName:  strings.TrimSpace(uidParts[1]),
 		UserEmail: uidParts[3],
+		Home:      home,
 	}
 
-	// Activate the newly imported key
-	if err := os.WriteFile(activateKeyPath, []byte(`hello, world!`), 0o600); err != nil {
-		return details, err
-	}
-	defer os.Remove(activateKeyPath)
-
-	_, err := Run("--local-user",
+	// Activate the newly imported key by signing some data, caching the passphrase
+	// within the gpg-agent. The passphrase is read from the first line of stdin,
+	// with the remaining input being signed
+	_, err = runHome(home, strings.NewReader(passphrase+"\nhello, world!"),
+		"--local-user",
 		fingerprint,
 		"--batch",
-		"--detach-sig",
+		"--no-tty",
 		"--pinentry-mode",
 		"loopback",
-		"--no-tty",
-		"--passphrase",
-		passphrase,
-		activateKeyPath)
+		"--passphrase-fd",
+		"0",
+		"--detach-sign",
+		"--output",
+		"-")
 	if err != nil {
-		return details, err
+		RemoveHome(home)
+		return KeyDetails{}, err
 	}
-	defer os.Remove(activateKeySigPath)
 
 	return details, nil
 }
 
+// RemoveHome stops any gpg-agent started for the ephemeral GNUPGHOME directory,
+// before removing it along with any imported keys
+func RemoveHome(home string) error {
+	// The agent may never have been started, so any error can be safely ignored
+	exec.Command("gpgconf", "--homedir", home, "--kill", "gpg-agent").Run()
+
+	return os.RemoveAll(home)
+}
+
 // Run executes a gpg command and returns its output or errors
 func Run(args ...string) (string, error) {
 	cmd := exec.Command("gpg", args...)
@@ -126,3 +141,13 @@ func Clean(output string, err error) (string, error) {
 	}
 	return output, err
 }
+
+func runHome(home string, stdin io.Reader, args ...string) (string, error) {
+	cmd := exec.Command("gpg", append([]string{"--homedir", home}, args...)...)
+	cmd.Stdin = stdin
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", errors.New(string(out))
+	}
+	return string(out), nil
+}
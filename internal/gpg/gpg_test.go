@@ -1,6 +1,7 @@
 package gpg
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,29 +19,52 @@ func TestIsInstalled_NotOnPath(t *testing.T) {
 }
 
 func TestImportKey(t *testing.T) {
-	t.Cleanup(func() {
-		Run("--batch", "--yes", "--delete-secret-keys", TestFingerprint)
-		Run("--batch", "--yes", "--delete-keys", TestFingerprint)
-	})
-
 	details, err := ImportKey(TestKey, TestPassphrase, TestFingerprint)
-
 	require.NoError(t, err)
+	t.Cleanup(func() { RemoveHome(details.Home) })
+
 	assert.Equal(t, "AAC7E54CBD73F690", details.ID)
 	assert.Equal(t, "john.smith", details.UserName)
 	assert.Equal(t, "john.smith@testing.com", details.UserEmail)
 }
 
 func TestImportKeyBase64(t *testing.T) {
-	t.Cleanup(func() {
-		Run("--batch", "--yes", "--delete-secret-keys", TestFingerprint)
-		Run("--batch", "--yes", "--delete-keys", TestFingerprint)
-	})
-
 	details, err := ImportKey(TestKeyBase64, TestPassphrase, TestFingerprint)
-
 	require.NoError(t, err)
+	t.Cleanup(func() { RemoveHome(details.Home) })
+
 	assert.Equal(t, TestKeyID, details.ID)
 	assert.Equal(t, TestKeyUserName, details.UserName)
 	assert.Equal(t, TestKeyUserEmail, details.UserEmail)
 }
+
+func TestImportKey_IsolatedHome(t *testing.T) {
+	t.Setenv("GNUPGHOME", t.TempDir())
+
+	details, err := ImportKey(TestKey, TestPassphrase, TestFingerprint)
+	require.NoError(t, err)
+	t.Cleanup(func() { RemoveHome(details.Home) })
+
+	info, err := os.Stat(details.Home)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+	_, err = Run("--batch", "--list-secret-keys", TestFingerprint)
+	assert.Error(t, err, "key should not exist within the default keyring")
+
+	_, err = Run("--homedir", details.Home, "--batch", "--list-secret-keys", TestFingerprint)
+	assert.NoError(t, err)
+}
+
+func TestImportKey_InvalidPassphrase(t *testing.T) {
+	_, err := ImportKey(TestKey, "invalid", TestFingerprint)
+	assert.Error(t, err)
+}
+
+func TestRemoveHome(t *testing.T) {
+	details, err := ImportKey(TestKey, TestPassphrase, TestFingerprint)
+	require.NoError(t, err)
+
+	require.NoError(t, RemoveHome(details.Home))
+	assert.NoDirExists(t, details.Home)
+}
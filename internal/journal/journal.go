@@ -0,0 +1,257 @@
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// Kind identifies the type of side effect recorded within a journal
+type Kind string
+
+const (
+	// FileWritten records that a file has been written to disk
+	FileWritten Kind = "file"
+	// Staged records that a set of paths have been staged
+	Staged Kind = "stage"
+	// Committed records that a commit has been made to the local branch
+	Committed Kind = "commit"
+	// Tagged records that a local tag has been created
+	Tagged Kind = "tag"
+	// Pushed records that a reference has been pushed to the remote
+	Pushed Kind = "push"
+	// Branched records that a new local branch has been created and checked out
+	Branched Kind = "branch"
+)
+
+// Entry records an individual side effect made against the repository
+type Entry struct {
+	Kind Kind
+	// Ref identifies the target of the side effect. This will be either a file
+	// path, a commit hash, a tag or a pushed reference
+	Ref string
+	// Paths contains all paths that were staged
+	Paths []string
+	// Original contains the contents of a file before it was written. Only
+	// set if the file existed beforehand
+	Original []byte
+	// Existed will be true if a written file existed beforehand
+	Existed bool
+	// Mode contains the permissions of a file before it was written. Only
+	// set if the file existed beforehand
+	Mode os.FileMode
+	// Parent contains the commit hash of HEAD before a commit was made, or
+	// the branch that was checked out before a new branch was created
+	Parent string
+}
+
+func (e Entry) String() string {
+	switch e.Kind {
+	case FileWritten:
+		return fmt.Sprintf("file %s", e.Ref)
+	case Staged:
+		return fmt.Sprintf("staged paths [%s]", strings.Join(e.Paths, " "))
+	case Committed:
+		return fmt.Sprintf("commit %s", e.Ref)
+	case Tagged:
+		return fmt.Sprintf("tag %s", e.Ref)
+	case Pushed:
+		return fmt.Sprintf("push of %s", e.Ref)
+	case Branched:
+		return fmt.Sprintf("branch %s", e.Ref)
+	}
+	return string(e.Kind)
+}
+
+// Journal tracks every side effect made to a repository during a release,
+// allowing them to be undone in reverse order should the release fail.
+// All methods are nil safe, a nil journal will record nothing
+type Journal struct {
+	entries []Entry
+}
+
+// Entries returns all side effects currently recorded within the journal
+func (j *Journal) Entries() []Entry {
+	if j == nil {
+		return nil
+	}
+	return j.entries
+}
+
+// FileWritten records that a file is about to be written. The original
+// contents and permissions of the file are captured so they can be restored
+func (j *Journal) FileWritten(path string) {
+	if j == nil {
+		return
+	}
+
+	e := Entry{Kind: FileWritten, Ref: path}
+	if fi, err := os.Stat(path); err == nil {
+		e.Mode = fi.Mode().Perm()
+		e.Original, err = os.ReadFile(path)
+		e.Existed = err == nil
+	}
+	j.entries = append(j.entries, e)
+}
+
+// Staged records that a set of paths have been staged
+func (j *Journal) Staged(paths ...string) {
+	if j == nil {
+		return
+	}
+
+	j.entries = append(j.entries, Entry{Kind: Staged, Paths: paths})
+}
+
+// Committed records a new commit along with its parent commit
+func (j *Journal) Committed(hash, parent string) {
+	if j == nil {
+		return
+	}
+
+	j.entries = append(j.entries, Entry{Kind: Committed, Ref: hash, Parent: parent})
+}
+
+// Rebased updates the most recently recorded commit after it has been rebased
+// onto a new parent, ensuring a rollback resets to the correct commit
+func (j *Journal) Rebased(hash, parent string) {
+	if j == nil {
+		return
+	}
+
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		if j.entries[i].Kind == Committed {
+			j.entries[i].Ref = hash
+			j.entries[i].Parent = parent
+			return
+		}
+	}
+}
+
+// Branched records that a new branch has been created and checked out from
+// the given base branch
+func (j *Journal) Branched(branch, base string) {
+	if j == nil {
+		return
+	}
+
+	j.entries = append(j.entries, Entry{Kind: Branched, Ref: branch, Parent: base})
+}
+
+// Tagged records the creation of a local tag
+func (j *Journal) Tagged(tag string) {
+	if j == nil {
+		return
+	}
+
+	j.entries = append(j.entries, Entry{Kind: Tagged, Ref: tag})
+}
+
+// Untagged removes a previously recorded local tag from the journal. Used
+// when a tag is intentionally deleted, such as a pre-tag
+func (j *Journal) Untagged(tag string) {
+	if j == nil {
+		return
+	}
+
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		if j.entries[i].Kind == Tagged && j.entries[i].Ref == tag {
+			j.entries = append(j.entries[:i], j.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// Pushed records that a reference has been pushed to the remote. Any side
+// effect recorded before a push can no longer be rolled back
+func (j *Journal) Pushed(ref string) {
+	if j == nil {
+		return
+	}
+
+	j.entries = append(j.entries, Entry{Kind: Pushed, Ref: ref})
+}
+
+// Rollback undoes all side effects recorded after the last push to the remote,
+// in reverse order. Any side effect that could not be undone, either because it
+// has been pushed or because undoing it failed, will be reported
+func (j *Journal) Rollback(gc *trace.GitClient) error {
+	if j == nil || len(j.entries) == 0 {
+		return nil
+	}
+
+	last := -1
+	for i, e := range j.entries {
+		if e.Kind == Pushed {
+			last = i
+		}
+	}
+
+	var errs []error
+	for i := len(j.entries) - 1; i > last; i-- {
+		e := j.entries[i]
+		if err := undo(gc, e); err != nil {
+			log.WithError(err).WithField("change", e.String()).Error("failed to rollback")
+			errs = append(errs, fmt.Errorf("%s: %w", e, err))
+			continue
+		}
+		log.WithField("change", e.String()).Info("rolled back")
+	}
+
+	for i := last; i >= 0; i-- {
+		if j.entries[i].Kind == Pushed {
+			continue
+		}
+		log.WithField("change", j.entries[i].String()).Warn("pushed to remote and cannot be rolled back")
+	}
+
+	j.entries = nil
+	return errors.Join(errs...)
+}
+
+func undo(gc *trace.GitClient, e Entry) error {
+	switch e.Kind {
+	case FileWritten:
+		if !e.Existed {
+			return os.Remove(e.Ref)
+		}
+		if err := os.WriteFile(e.Ref, e.Original, e.Mode); err != nil {
+			return err
+		}
+		// Permissions of an existing file are left untouched when written
+		return os.Chmod(e.Ref, e.Mode)
+	case Staged:
+		paths := make([]string, 0, len(e.Paths))
+		for _, p := range e.Paths {
+			quoted, err := syntax.Quote(p, syntax.LangBash)
+			if err != nil {
+				return err
+			}
+			paths = append(paths, quoted)
+		}
+
+		_, err := gc.Exec("git reset -q -- " + strings.Join(paths, " "))
+		return err
+	case Committed:
+		_, err := gc.Exec("git reset -q --soft " + e.Parent)
+		return err
+	case Tagged:
+		_, err := gc.DeleteTag(e.Ref, git.WithLocalDelete())
+		return err
+	case Branched:
+		// Any uncommitted changes are carried across to the base branch
+		if _, err := gc.Exec("git checkout -q " + e.Parent); err != nil {
+			return err
+		}
+		_, err := gc.Exec("git branch -D " + e.Ref)
+		return err
+	}
+
+	return nil
+}
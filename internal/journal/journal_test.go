@@ -0,0 +1,183 @@
+package journal_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/journal"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNilJournal(t *testing.T) {
+	var j *journal.Journal
+	j.FileWritten("test.txt")
+	j.Staged("test.txt")
+	j.Committed("abcdef", "123456")
+	j.Rebased("fedcba", "654321")
+	j.Branched("uplift/release-v0.1.0", "main")
+	j.Tagged("v0.1.0")
+	j.Untagged("v0.1.0")
+	j.Pushed("v0.1.0")
+
+	assert.Empty(t, j.Entries())
+	assert.NoError(t, j.Rollback(nil))
+}
+
+func TestUntagged(t *testing.T) {
+	j := &journal.Journal{}
+	j.Tagged("v0.1.0")
+	j.Tagged("v0.2.0")
+	j.Untagged("v0.1.0")
+
+	require.Len(t, j.Entries(), 1)
+	assert.Equal(t, "v0.2.0", j.Entries()[0].Ref)
+}
+
+func TestRebased(t *testing.T) {
+	j := &journal.Journal{}
+	j.Committed("abcdef", "123456")
+	j.Tagged("v0.1.0")
+	j.Rebased("fedcba", "654321")
+
+	require.Len(t, j.Entries(), 2)
+	assert.Equal(t, "fedcba", j.Entries()[0].Ref)
+	assert.Equal(t, "654321", j.Entries()[0].Parent)
+}
+
+func TestRollback(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithCommittedFiles("test.txt"),
+		gittest.WithFileContent("test.txt", "version: 0.1.0"))
+	parent := gittest.LastCommit(t).Hash
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	j := &journal.Journal{}
+	j.FileWritten("test.txt")
+	gittest.WriteFile(t, "test.txt", "version: 0.2.0", 0o644)
+	j.FileWritten("CHANGELOG.md")
+	gittest.WriteFile(t, "CHANGELOG.md", "# Changelog", 0o644)
+	gittest.StageFile(t, "test.txt")
+	gittest.StageFile(t, "CHANGELOG.md")
+	j.Staged("test.txt", "CHANGELOG.md")
+	gittest.Commit(t, "ci(uplift): uplifted for version 0.2.0")
+	j.Committed(gittest.LastCommit(t).Hash, parent)
+	gittest.Tag(t, "0.2.0")
+	j.Tagged("0.2.0")
+
+	require.NoError(t, j.Rollback(gc))
+
+	assert.Equal(t, parent, gittest.LastCommit(t).Hash)
+	assert.Empty(t, gittest.Tags(t))
+	assert.Empty(t, gittest.PorcelainStatus(t))
+
+	data, err := os.ReadFile("test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "version: 0.1.0", string(data))
+	assert.NoFileExists(t, "CHANGELOG.md")
+	assert.Empty(t, j.Entries())
+}
+
+func TestRollback_RestoresFileMode(t *testing.T) {
+	gittest.InitRepository(t)
+	require.NoError(t, os.WriteFile("bump.sh", []byte("echo 0.1.0"), 0o755))
+
+	j := &journal.Journal{}
+	j.FileWritten("bump.sh")
+	require.NoError(t, os.Remove("bump.sh"))
+	require.NoError(t, os.WriteFile("bump.sh", []byte("echo 0.2.0"), 0o644))
+
+	require.NoError(t, j.Rollback(nil))
+
+	fi, err := os.Stat("bump.sh")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), fi.Mode().Perm())
+
+	data, err := os.ReadFile("bump.sh")
+	require.NoError(t, err)
+	assert.Equal(t, "echo 0.1.0", string(data))
+}
+
+func TestRollback_StagedQuotesPaths(t *testing.T) {
+	gittest.InitRepository(t)
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	j := &journal.Journal{}
+	gittest.TempFile(t, "release notes.md", "# Release")
+	gittest.TempFile(t, "it's$HOME.txt", "version: 0.1.0")
+	gittest.StageFile(t, "release notes.md")
+	gittest.StageFile(t, "it's$HOME.txt")
+	j.Staged("release notes.md", "it's$HOME.txt")
+
+	require.NoError(t, j.Rollback(gc))
+
+	assert.ElementsMatch(t, []string{`?? "release notes.md"`, "?? it's$HOME.txt"}, gittest.PorcelainStatus(t))
+}
+
+func TestRollback_Branched(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+	parent := gittest.LastCommit(t).Hash
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	j := &journal.Journal{}
+	gittest.MustExec(t, "git checkout -b uplift/release-v0.1.0")
+	j.Branched("uplift/release-v0.1.0", gittest.DefaultBranch)
+	gittest.Commit(t, "ci(uplift): uplifted for version v0.1.0")
+	j.Committed(gittest.LastCommit(t).Hash, parent)
+
+	require.NoError(t, j.Rollback(gc))
+
+	assert.Equal(t, gittest.DefaultBranch, gittest.MustExec(t, "git branch --show-current"))
+	assert.Equal(t, parent, gittest.LastCommit(t).Hash)
+	assert.Empty(t, gittest.MustExec(t, "git branch --list uplift/release-v0.1.0"))
+	assert.Equal(t, []string{"A  test.txt"}, gittest.PorcelainStatus(t))
+}
+
+func TestRollback_StopsAtPush(t *testing.T) {
+	gittest.InitRepository(t)
+	parent := gittest.LastCommit(t).Hash
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	j := &journal.Journal{}
+	gittest.CommitEmpty(t, "ci(uplift): uplifted for version 0.1.0")
+	commit := gittest.LastCommit(t).Hash
+	j.Committed(commit, parent)
+	j.Pushed(commit)
+	gittest.Tag(t, "0.1.0")
+	j.Tagged("0.1.0")
+
+	require.NoError(t, j.Rollback(gc))
+
+	// Only the tag can be rolled back as the commit has been pushed
+	assert.Equal(t, commit, gittest.LastCommit(t).Hash)
+	assert.Empty(t, gittest.Tags(t))
+}
+
+func TestRollback_ReportsFailures(t *testing.T) {
+	gittest.InitRepository(t)
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+	gc := trace.NewGitClient(gitc, nil)
+
+	j := &journal.Journal{}
+	j.Tagged("missing")
+
+	err = j.Rollback(gc)
+	assert.ErrorContains(t, err, "tag missing")
+}
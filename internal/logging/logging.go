@@ -0,0 +1,239 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/cli"
+	"github.com/apex/log/handlers/discard"
+	"github.com/apex/log/handlers/json"
+	"github.com/apex/log/handlers/logfmt"
+	"github.com/apex/log/handlers/multi"
+)
+
+const (
+	// FormatCLI writes human friendly logs, indented by task
+	FormatCLI = "cli"
+
+	// FormatText writes plain text logs in the logfmt style, without any colors
+	FormatText = "text"
+
+	// FormatJSON writes a JSON object per log entry
+	FormatJSON = "json"
+)
+
+// Formats lists all supported log formats
+var Formats = []string{FormatCLI, FormatText, FormatJSON}
+
+// Outcomes of an executed task
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailed  = "failed"
+	OutcomeSkipped = "skipped"
+)
+
+// Options for configuring how uplift logs
+type Options struct {
+	// Format of all logs written to stderr. Defaults to cli
+	Format string
+
+	// File will receive a copy of all logs, using the selected format. As the
+	// cli format is intended for a terminal, json will be used instead
+	File string
+
+	Debug  bool
+	Silent bool
+}
+
+var (
+	mu      sync.RWMutex
+	current string
+
+	// Handlers writing structured logs, which receive the outcome of each task
+	structured []log.Handler
+)
+
+// Setup configures the global logger. If logs are written to a file, it must be
+// closed once uplift completes
+func Setup(opts Options) (io.Closer, error) {
+	format := opts.Format
+	if format == "" {
+		format = FormatCLI
+	}
+
+	var handlers, structuredHandlers []log.Handler
+
+	h, err := handler(format, os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.Silent {
+		handlers = append(handlers, h)
+		if format != FormatCLI {
+			structuredHandlers = append(structuredHandlers, h)
+		}
+	}
+
+	var closer io.Closer = nopCloser{}
+	if opts.File != "" {
+		f, err := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		closer = f
+
+		// The cli format is intended for a terminal
+		fileFormat := format
+		if fileFormat == FormatCLI {
+			fileFormat = FormatJSON
+		}
+
+		fh, _ := handler(fileFormat, f)
+		handlers = append(handlers, fh)
+		structuredHandlers = append(structuredHandlers, fh)
+	}
+
+	mu.Lock()
+	structured = structuredHandlers
+	mu.Unlock()
+
+	switch len(handlers) {
+	case 0:
+		log.SetHandler(discard.Default)
+	case 1:
+		log.SetHandler(handlers[0])
+	default:
+		log.SetHandler(multi.New(handlers...))
+	}
+
+	if opts.Debug {
+		log.SetLevel(log.InvalidLevel)
+	}
+
+	return closer, nil
+}
+
+func handler(format string, w io.Writer) (log.Handler, error) {
+	switch format {
+	case FormatCLI:
+		// Tasks rely on the padding of the default handler
+		return cli.Default, nil
+	case FormatText:
+		return &taskHandler{Handler: logfmt.New(w)}, nil
+	case FormatJSON:
+		return &taskHandler{Handler: json.New(w)}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported log format '%s', must be one of %v", format, Formats)
+}
+
+// Pretty identifies if logs are written to a terminal using the cli format
+func Pretty() bool {
+	l, ok := log.Log.(*log.Logger)
+	if !ok {
+		return false
+	}
+
+	switch h := l.Handler.(type) {
+	case *cli.Handler:
+		return true
+	case *multi.Handler:
+		for _, mh := range h.Handlers {
+			if _, ok := mh.(*cli.Handler); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// StartTask marks the task that is currently executing. Every structured log
+// entry written while the task executes will include its name
+func StartTask(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = name
+}
+
+// EndTask records the outcome of the currently executing task, along with its
+// duration. Only written to structured logs
+func EndTask(duration time.Duration, outcome string, err error) {
+	mu.Lock()
+	name := current
+	current = ""
+	handlers := structured
+	mu.Unlock()
+
+	l, ok := log.Log.(*log.Logger)
+	if !ok || len(handlers) == 0 {
+		return
+	}
+
+	level := log.InfoLevel
+	if outcome == OutcomeSkipped {
+		level = log.DebugLevel
+	}
+
+	if level < l.Level {
+		return
+	}
+
+	fields := log.Fields{
+		"task":        name,
+		"duration_ms": duration.Milliseconds(),
+		"outcome":     outcome,
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	e := &log.Entry{
+		Logger:    l,
+		Fields:    fields,
+		Level:     level,
+		Timestamp: time.Now(),
+		Message:   "task completed",
+	}
+	for _, h := range handlers {
+		_ = h.HandleLog(e)
+	}
+}
+
+// taskHandler annotates every log entry with the name of the task that is
+// currently executing
+type taskHandler struct {
+	log.Handler
+}
+
+func (h *taskHandler) HandleLog(e *log.Entry) error {
+	mu.RLock()
+	name := current
+	mu.RUnlock()
+
+	if name == "" {
+		return h.Handler.HandleLog(e)
+	}
+
+	if _, ok := e.Fields["task"]; ok {
+		return h.Handler.HandleLog(e)
+	}
+
+	fields := make(log.Fields, len(e.Fields)+1)
+	for k, v := range e.Fields {
+		fields[k] = v
+	}
+	fields["task"] = name
+
+	entry := *e
+	entry.Fields = fields
+	return h.Handler.HandleLog(&entry)
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
@@ -0,0 +1,137 @@
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup_UnsupportedFormat(t *testing.T) {
+	_, err := Setup(Options{Format: "xml"})
+	require.EqualError(t, err, "unsupported log format 'xml', must be one of [cli text json]")
+}
+
+func TestSetup_JSONFile(t *testing.T) {
+	path := setup(t, Options{Format: FormatJSON, Silent: true})
+
+	StartTask("next semantic version")
+	log.WithField("version", "v1.0.0").Info("identified next version")
+	EndTask(1500*time.Millisecond, OutcomeSuccess, nil)
+	log.Info("outside of a task")
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, "identified next version", entries[0]["message"])
+	assert.Equal(t, "info", entries[0]["level"])
+	assert.Equal(t, map[string]interface{}{
+		"task":    "next semantic version",
+		"version": "v1.0.0",
+	}, entries[0]["fields"])
+
+	assert.Equal(t, "task completed", entries[1]["message"])
+	assert.Equal(t, map[string]interface{}{
+		"task":        "next semantic version",
+		"duration_ms": float64(1500),
+		"outcome":     OutcomeSuccess,
+	}, entries[1]["fields"])
+
+	assert.Equal(t, "outside of a task", entries[2]["message"])
+	assert.Empty(t, entries[2]["fields"])
+}
+
+func TestSetup_CLIWritesJSONFile(t *testing.T) {
+	path := setup(t, Options{Format: FormatCLI})
+
+	assert.True(t, Pretty())
+
+	StartTask("committing changes")
+	EndTask(time.Second, OutcomeFailed, errors.New("push rejected"))
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"task":        "committing changes",
+		"duration_ms": float64(1000),
+		"outcome":     OutcomeFailed,
+		"error":       "push rejected",
+	}, entries[0]["fields"])
+}
+
+func TestSetup_TextFile(t *testing.T) {
+	path := setup(t, Options{Format: FormatText, Silent: true})
+
+	assert.False(t, Pretty())
+
+	StartTask("bumping files")
+	log.Info("bumped file")
+	EndTask(0, OutcomeSuccess, nil)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "bumped file")
+	assert.Contains(t, string(data), `task="bumping files"`)
+	assert.NotContains(t, string(data), "\x1b[")
+}
+
+func TestEndTask_SkippedOnlyInDebug(t *testing.T) {
+	path := setup(t, Options{Format: FormatJSON, Silent: true})
+
+	StartTask("changelog")
+	EndTask(0, OutcomeSkipped, nil)
+	assert.Empty(t, readEntries(t, path))
+
+	log.SetLevel(log.DebugLevel)
+	StartTask("changelog")
+	EndTask(0, OutcomeSkipped, nil)
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "debug", entries[0]["level"])
+}
+
+func setup(t *testing.T, opts Options) string {
+	t.Helper()
+
+	opts.File = filepath.Join(t.TempDir(), "uplift.log")
+	closer, err := Setup(opts)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		closer.Close()
+		log.SetHandler(cli.Default)
+		log.SetLevel(log.InfoLevel)
+	})
+	return opts.File
+}
+
+func readEntries(t *testing.T, path string) []map[string]interface{} {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -0,0 +1,53 @@
+package pullrequest
+
+import (
+	"fmt"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+)
+
+// ErrUnsupportedProvider is raised when attempting to manage pull requests
+// against an SCM provider that is not supported
+type ErrUnsupportedProvider struct {
+	provider context.SCMProvider
+}
+
+// Error returns a formatted message of the current error
+func (e ErrUnsupportedProvider) Error() string {
+	return fmt.Sprintf("pull requests are not supported for scm provider %s. Supported providers are GitHub, GitLab and Gitea",
+		e.provider)
+}
+
+// ErrMissingToken is raised when no token has been provided for authenticating
+// with the API of an SCM provider
+type ErrMissingToken struct {
+	env string
+}
+
+// Error returns a formatted message of the current error
+func (e ErrMissingToken) Error() string {
+	return fmt.Sprintf("a token is required to manage pull requests. Please set the %s environment variable", e.env)
+}
+
+// ErrNotFound is raised when no pull request exists for a given branch
+type ErrNotFound struct {
+	branch string
+}
+
+// Error returns a formatted message of the current error
+func (e ErrNotFound) Error() string {
+	return fmt.Sprintf("no pull request found for branch %s", e.branch)
+}
+
+// ErrAPI is raised when an SCM provider responds with an unexpected status code
+type ErrAPI struct {
+	method string
+	url    string
+	status int
+	body   string
+}
+
+// Error returns a formatted message of the current error
+func (e ErrAPI) Error() string {
+	return fmt.Sprintf("%s %s failed with status %d: %s", e.method, e.url, e.status, e.body)
+}
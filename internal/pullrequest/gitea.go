@@ -0,0 +1,63 @@
+package pullrequest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type gitea struct {
+	api  api
+	repo string
+}
+
+type giteaPull struct {
+	Number         int    `json:"number"`
+	HTMLURL        string `json:"html_url"`
+	Merged         bool   `json:"merged"`
+	MergeCommitSHA string `json:"merge_commit_sha"`
+	Head           struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+}
+
+func (p giteaPull) toPullRequest() PullRequest {
+	return PullRequest{
+		Number:      p.Number,
+		URL:         p.HTMLURL,
+		Merged:      p.Merged,
+		MergeCommit: p.MergeCommitSHA,
+	}
+}
+
+// https://try.gitea.io/api/swagger#/repository/repoCreatePullRequest
+func (g gitea) Open(req Request) (PullRequest, error) {
+	body := map[string]string{
+		"title": req.Title,
+		"body":  req.Body,
+		"head":  req.Head,
+		"base":  req.Base,
+	}
+
+	var pull giteaPull
+	if err := g.api.do(http.MethodPost, fmt.Sprintf("/repos/%s/pulls", g.repo), body, &pull); err != nil {
+		return PullRequest{}, err
+	}
+	return pull.toPullRequest(), nil
+}
+
+// https://try.gitea.io/api/swagger#/repository/repoListPullRequests
+func (g gitea) Find(branch string) (PullRequest, error) {
+	// Gitea does not support filtering pull requests by branch, so the most
+	// recently created pull requests are searched instead
+	var pulls []giteaPull
+	if err := g.api.do(http.MethodGet, fmt.Sprintf("/repos/%s/pulls?state=all&sort=newest&limit=50", g.repo), nil, &pulls); err != nil {
+		return PullRequest{}, err
+	}
+
+	for _, p := range pulls {
+		if p.Head.Ref == branch {
+			return p.toPullRequest(), nil
+		}
+	}
+	return PullRequest{}, ErrNotFound{branch: branch}
+}
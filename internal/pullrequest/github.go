@@ -0,0 +1,60 @@
+package pullrequest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type github struct {
+	api  api
+	repo string
+}
+
+type githubPull struct {
+	Number         int     `json:"number"`
+	HTMLURL        string  `json:"html_url"`
+	MergedAt       *string `json:"merged_at"`
+	MergeCommitSHA string  `json:"merge_commit_sha"`
+}
+
+func (p githubPull) toPullRequest() PullRequest {
+	return PullRequest{
+		Number:      p.Number,
+		URL:         p.HTMLURL,
+		Merged:      p.MergedAt != nil,
+		MergeCommit: p.MergeCommitSHA,
+	}
+}
+
+// https://docs.github.com/en/rest/pulls/pulls#create-a-pull-request
+func (g github) Open(req Request) (PullRequest, error) {
+	body := map[string]string{
+		"title": req.Title,
+		"body":  req.Body,
+		"head":  req.Head,
+		"base":  req.Base,
+	}
+
+	var pull githubPull
+	if err := g.api.do(http.MethodPost, fmt.Sprintf("/repos/%s/pulls", g.repo), body, &pull); err != nil {
+		return PullRequest{}, err
+	}
+	return pull.toPullRequest(), nil
+}
+
+// https://docs.github.com/en/rest/pulls/pulls#list-pull-requests
+func (g github) Find(branch string) (PullRequest, error) {
+	path := fmt.Sprintf("/repos/%s/pulls?state=all&head=%s",
+		g.repo, url.QueryEscape(owner(g.repo)+":"+branch))
+
+	var pulls []githubPull
+	if err := g.api.do(http.MethodGet, path, nil, &pulls); err != nil {
+		return PullRequest{}, err
+	}
+
+	if len(pulls) == 0 {
+		return PullRequest{}, ErrNotFound{branch: branch}
+	}
+	return pulls[0].toPullRequest(), nil
+}
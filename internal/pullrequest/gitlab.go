@@ -0,0 +1,72 @@
+package pullrequest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type gitlab struct {
+	api  api
+	repo string
+}
+
+type gitlabMerge struct {
+	IID             int    `json:"iid"`
+	WebURL          string `json:"web_url"`
+	State           string `json:"state"`
+	SHA             string `json:"sha"`
+	MergeCommitSHA  string `json:"merge_commit_sha"`
+	SquashCommitSHA string `json:"squash_commit_sha"`
+}
+
+func (m gitlabMerge) toPullRequest() PullRequest {
+	// Depending on the merge method of the project, the commit that lands on
+	// the target branch will be tracked differently
+	commit := m.MergeCommitSHA
+	if commit == "" {
+		commit = m.SquashCommitSHA
+	}
+	if commit == "" {
+		commit = m.SHA
+	}
+
+	return PullRequest{
+		Number:      m.IID,
+		URL:         m.WebURL,
+		Merged:      m.State == "merged",
+		MergeCommit: commit,
+	}
+}
+
+// https://docs.gitlab.com/ee/api/merge_requests.html#create-mr
+func (g gitlab) Open(req Request) (PullRequest, error) {
+	body := map[string]string{
+		"title":         req.Title,
+		"description":   req.Body,
+		"source_branch": req.Head,
+		"target_branch": req.Base,
+	}
+
+	var merge gitlabMerge
+	if err := g.api.do(http.MethodPost, fmt.Sprintf("/projects/%s/merge_requests", url.PathEscape(g.repo)), body, &merge); err != nil {
+		return PullRequest{}, err
+	}
+	return merge.toPullRequest(), nil
+}
+
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests
+func (g gitlab) Find(branch string) (PullRequest, error) {
+	path := fmt.Sprintf("/projects/%s/merge_requests?state=all&source_branch=%s",
+		url.PathEscape(g.repo), url.QueryEscape(branch))
+
+	var merges []gitlabMerge
+	if err := g.api.do(http.MethodGet, path, nil, &merges); err != nil {
+		return PullRequest{}, err
+	}
+
+	if len(merges) == 0 {
+		return PullRequest{}, ErrNotFound{branch: branch}
+	}
+	return merges[0].toPullRequest(), nil
+}
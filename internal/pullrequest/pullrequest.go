@@ -0,0 +1,153 @@
+package pullrequest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+)
+
+const (
+	// GitHubTokenEnv contains the name of the environment variable that holds
+	// the token used to authenticate with the GitHub API
+	GitHubTokenEnv = "GITHUB_TOKEN"
+
+	// GitLabTokenEnv contains the name of the environment variable that holds
+	// the token used to authenticate with the GitLab API
+	GitLabTokenEnv = "GITLAB_TOKEN"
+
+	// GiteaTokenEnv contains the name of the environment variable that holds
+	// the token used to authenticate with the Gitea API
+	GiteaTokenEnv = "GITEA_TOKEN"
+)
+
+// Request contains the details needed to open a new pull request
+type Request struct {
+	Title string
+	Body  string
+	Head  string
+	Base  string
+}
+
+// PullRequest contains details of a pull request (or merge request) that
+// exists within an SCM provider
+type PullRequest struct {
+	Number      int
+	URL         string
+	Merged      bool
+	MergeCommit string
+}
+
+// Client provides a way of managing pull requests through the API of an
+// SCM provider
+type Client interface {
+	// Open a new pull request
+	Open(req Request) (PullRequest, error)
+
+	// Find the most recent pull request opened from the given branch
+	Find(branch string) (PullRequest, error)
+}
+
+// NewClient creates a client for managing pull requests against the detected
+// SCM provider. A token for authenticating with the API is read from the
+// environment variable associated with each provider
+func NewClient(scm context.SCM) (Client, error) {
+	var env string
+	switch scm.Provider {
+	case context.GitHub:
+		env = GitHubTokenEnv
+	case context.GitLab:
+		env = GitLabTokenEnv
+	case context.Gitea:
+		env = GiteaTokenEnv
+	default:
+		return nil, ErrUnsupportedProvider{provider: scm.Provider}
+	}
+
+	token := os.Getenv(env)
+	if token == "" {
+		return nil, ErrMissingToken{env: env}
+	}
+
+	a := api{
+		baseURL: strings.TrimSuffix(scm.APIURL, "/"),
+		header:  http.Header{},
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+
+	switch scm.Provider {
+	case context.GitHub:
+		a.header.Set("Authorization", "Bearer "+token)
+		a.header.Set("Accept", "application/vnd.github+json")
+		return github{api: a, repo: scm.Repo}, nil
+	case context.GitLab:
+		a.header.Set("PRIVATE-TOKEN", token)
+		return gitlab{api: a, repo: scm.Repo}, nil
+	default:
+		a.header.Set("Authorization", "token "+token)
+		return gitea{api: a, repo: scm.Repo}, nil
+	}
+}
+
+type api struct {
+	baseURL string
+	header  http.Header
+	client  *http.Client
+}
+
+func (a api) do(method, path string, body, out any) error {
+	var rdr io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		rdr = bytes.NewReader(data)
+	}
+
+	url := a.baseURL + path
+	req, err := http.NewRequest(method, url, rdr)
+	if err != nil {
+		return err
+	}
+	req.Header = a.header.Clone()
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return ErrAPI{
+			method: method,
+			url:    url,
+			status: resp.StatusCode,
+			body:   strings.TrimSpace(string(msg)),
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response from %s: %w", url, err)
+	}
+	return nil
+}
+
+func owner(repo string) string {
+	if idx := strings.Index(repo, "/"); idx > -1 {
+		return repo[:idx]
+	}
+	return repo
+}
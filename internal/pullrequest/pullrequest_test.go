@@ -0,0 +1,260 @@
+package pullrequest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/pullrequest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newServer(t *testing.T, routes map[string]http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for pattern, h := range routes {
+		mux.HandleFunc(pattern, h)
+	}
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func decodeBody(t *testing.T, r *http.Request) map[string]string {
+	t.Helper()
+
+	body := map[string]string{}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	return body
+}
+
+func TestNewClient_UnsupportedProvider(t *testing.T) {
+	_, err := pullrequest.NewClient(context.SCM{Provider: context.CodeCommit})
+	require.ErrorAs(t, err, &pullrequest.ErrUnsupportedProvider{})
+}
+
+func TestNewClient_MissingToken(t *testing.T) {
+	t.Setenv(pullrequest.GitHubTokenEnv, "")
+
+	_, err := pullrequest.NewClient(context.SCM{Provider: context.GitHub})
+	require.EqualError(t, err, "a token is required to manage pull requests. Please set the GITHUB_TOKEN environment variable")
+}
+
+func TestGitHub_Open(t *testing.T) {
+	t.Setenv(pullrequest.GitHubTokenEnv, "gh-token")
+
+	srv := newServer(t, map[string]http.HandlerFunc{
+		"POST /repos/owner/repo/pulls": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer gh-token", r.Header.Get("Authorization"))
+			assert.Equal(t, map[string]string{
+				"title": "ci(uplift): uplifted for version v0.1.0",
+				"body":  "## v0.1.0",
+				"head":  "uplift/release-v0.1.0",
+				"base":  "main",
+			}, decodeBody(t, r))
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"number": 12, "html_url": "https://github.com/owner/repo/pull/12"}`))
+		},
+	})
+
+	client, err := pullrequest.NewClient(context.SCM{Provider: context.GitHub, APIURL: srv.URL, Repo: "owner/repo"})
+	require.NoError(t, err)
+
+	pr, err := client.Open(pullrequest.Request{
+		Title: "ci(uplift): uplifted for version v0.1.0",
+		Body:  "## v0.1.0",
+		Head:  "uplift/release-v0.1.0",
+		Base:  "main",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pullrequest.PullRequest{Number: 12, URL: "https://github.com/owner/repo/pull/12"}, pr)
+}
+
+func TestGitHub_Find(t *testing.T) {
+	t.Setenv(pullrequest.GitHubTokenEnv, "gh-token")
+
+	srv := newServer(t, map[string]http.HandlerFunc{
+		"GET /repos/owner/repo/pulls": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "owner:uplift/release-v0.1.0", r.URL.Query().Get("head"))
+			assert.Equal(t, "all", r.URL.Query().Get("state"))
+
+			w.Write([]byte(`[{
+				"number": 12,
+				"html_url": "https://github.com/owner/repo/pull/12",
+				"merged_at": "2026-10-19T09:00:00Z",
+				"merge_commit_sha": "a1b2c3d4"
+			}]`))
+		},
+	})
+
+	client, err := pullrequest.NewClient(context.SCM{Provider: context.GitHub, APIURL: srv.URL, Repo: "owner/repo"})
+	require.NoError(t, err)
+
+	pr, err := client.Find("uplift/release-v0.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, pullrequest.PullRequest{
+		Number:      12,
+		URL:         "https://github.com/owner/repo/pull/12",
+		Merged:      true,
+		MergeCommit: "a1b2c3d4",
+	}, pr)
+}
+
+func TestGitHub_FindNotFound(t *testing.T) {
+	t.Setenv(pullrequest.GitHubTokenEnv, "gh-token")
+
+	srv := newServer(t, map[string]http.HandlerFunc{
+		"GET /repos/owner/repo/pulls": func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte(`[]`))
+		},
+	})
+
+	client, err := pullrequest.NewClient(context.SCM{Provider: context.GitHub, APIURL: srv.URL, Repo: "owner/repo"})
+	require.NoError(t, err)
+
+	_, err = client.Find("uplift/release-v0.1.0")
+	require.ErrorAs(t, err, &pullrequest.ErrNotFound{})
+}
+
+func TestGitLab_Open(t *testing.T) {
+	t.Setenv(pullrequest.GitLabTokenEnv, "gl-token")
+
+	srv := newServer(t, map[string]http.HandlerFunc{
+		"POST /projects/{id}/merge_requests": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/projects/group%2Frepo/merge_requests", r.URL.EscapedPath())
+			assert.Equal(t, "gl-token", r.Header.Get("PRIVATE-TOKEN"))
+			assert.Equal(t, map[string]string{
+				"title":         "ci(uplift): uplifted for version v0.1.0",
+				"description":   "## v0.1.0",
+				"source_branch": "uplift/release-v0.1.0",
+				"target_branch": "main",
+			}, decodeBody(t, r))
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"iid": 3, "web_url": "https://gitlab.com/group/repo/-/merge_requests/3", "state": "opened"}`))
+		},
+	})
+
+	client, err := pullrequest.NewClient(context.SCM{Provider: context.GitLab, APIURL: srv.URL, Repo: "group/repo"})
+	require.NoError(t, err)
+
+	pr, err := client.Open(pullrequest.Request{
+		Title: "ci(uplift): uplifted for version v0.1.0",
+		Body:  "## v0.1.0",
+		Head:  "uplift/release-v0.1.0",
+		Base:  "main",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pullrequest.PullRequest{Number: 3, URL: "https://gitlab.com/group/repo/-/merge_requests/3"}, pr)
+}
+
+func TestGitLab_Find(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		commit   string
+	}{
+		{
+			name:     "MergeCommit",
+			response: `[{"iid": 3, "state": "merged", "sha": "c3", "merge_commit_sha": "c1", "squash_commit_sha": "c2"}]`,
+			commit:   "c1",
+		},
+		{
+			name:     "SquashCommit",
+			response: `[{"iid": 3, "state": "merged", "sha": "c3", "squash_commit_sha": "c2"}]`,
+			commit:   "c2",
+		},
+		{
+			name:     "FastForward",
+			response: `[{"iid": 3, "state": "merged", "sha": "c3"}]`,
+			commit:   "c3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(pullrequest.GitLabTokenEnv, "gl-token")
+
+			srv := newServer(t, map[string]http.HandlerFunc{
+				"GET /projects/{id}/merge_requests": func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "uplift/release-v0.1.0", r.URL.Query().Get("source_branch"))
+					w.Write([]byte(tt.response))
+				},
+			})
+
+			client, err := pullrequest.NewClient(context.SCM{Provider: context.GitLab, APIURL: srv.URL, Repo: "group/repo"})
+			require.NoError(t, err)
+
+			pr, err := client.Find("uplift/release-v0.1.0")
+			require.NoError(t, err)
+			assert.True(t, pr.Merged)
+			assert.Equal(t, tt.commit, pr.MergeCommit)
+		})
+	}
+}
+
+func TestGitea_Open(t *testing.T) {
+	t.Setenv(pullrequest.GiteaTokenEnv, "gt-token")
+
+	srv := newServer(t, map[string]http.HandlerFunc{
+		"POST /repos/owner/repo/pulls": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "token gt-token", r.Header.Get("Authorization"))
+			assert.Equal(t, "uplift/release-v0.1.0", decodeBody(t, r)["head"])
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"number": 7, "html_url": "https://gitea.com/owner/repo/pulls/7"}`))
+		},
+	})
+
+	client, err := pullrequest.NewClient(context.SCM{Provider: context.Gitea, APIURL: srv.URL, Repo: "owner/repo"})
+	require.NoError(t, err)
+
+	pr, err := client.Open(pullrequest.Request{Head: "uplift/release-v0.1.0", Base: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, pullrequest.PullRequest{Number: 7, URL: "https://gitea.com/owner/repo/pulls/7"}, pr)
+}
+
+func TestGitea_Find(t *testing.T) {
+	t.Setenv(pullrequest.GiteaTokenEnv, "gt-token")
+
+	srv := newServer(t, map[string]http.HandlerFunc{
+		"GET /repos/owner/repo/pulls": func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte(`[
+				{"number": 8, "merged": false, "head": {"ref": "feature"}},
+				{"number": 7, "merged": true, "merge_commit_sha": "a1b2c3d4", "head": {"ref": "uplift/release-v0.1.0"}}
+			]`))
+		},
+	})
+
+	client, err := pullrequest.NewClient(context.SCM{Provider: context.Gitea, APIURL: srv.URL, Repo: "owner/repo"})
+	require.NoError(t, err)
+
+	pr, err := client.Find("uplift/release-v0.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, 7, pr.Number)
+	assert.True(t, pr.Merged)
+	assert.Equal(t, "a1b2c3d4", pr.MergeCommit)
+}
+
+func TestAPIError(t *testing.T) {
+	t.Setenv(pullrequest.GitHubTokenEnv, "gh-token")
+
+	srv := newServer(t, map[string]http.HandlerFunc{
+		"POST /repos/owner/repo/pulls": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "Validation Failed"}`))
+		},
+	})
+
+	client, err := pullrequest.NewClient(context.SCM{Provider: context.GitHub, APIURL: srv.URL, Repo: "owner/repo"})
+	require.NoError(t, err)
+
+	_, err = client.Open(pullrequest.Request{})
+	require.ErrorAs(t, err, &pullrequest.ErrAPI{})
+	assert.Contains(t, err.Error(), `failed with status 422: {"message": "Validation Failed"}`)
+}
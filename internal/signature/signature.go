@@ -0,0 +1,133 @@
+package signature
+
+import (
+	"strings"
+
+	"github.com/gembaadvantage/uplift/internal/trace"
+)
+
+// Status describes the outcome of git verifying a commit signature
+type Status string
+
+const (
+	Good            Status = "good"
+	UnknownValidity Status = "unknown validity"
+	Expired         Status = "expired"
+	ExpiredKey      Status = "expired key"
+	RevokedKey      Status = "revoked key"
+	Unverifiable    Status = "unverifiable"
+	Bad             Status = "bad"
+	Unsigned        Status = "unsigned"
+)
+
+// Maps the placeholder %G? reported by git log onto a signature status
+var statuses = map[string]Status{
+	"G": Good,
+	"U": UnknownValidity,
+	"X": Expired,
+	"Y": ExpiredKey,
+	"R": RevokedKey,
+	"E": Unverifiable,
+	"B": Bad,
+	"N": Unsigned,
+}
+
+// ASCII unit separator, guaranteed not to appear within any field
+const separator = "\x1f"
+
+// Signature contains details about the signature of a single commit
+type Signature struct {
+	Hash        string
+	Status      Status
+	Key         string
+	Fingerprint string
+	Signer      string
+
+	// Verified is true if the signature is valid and was made by an allowed key
+	Verified bool
+}
+
+// Valid identifies whether the signature is good and made by a trusted key
+func (s Signature) Valid() bool {
+	return s.Status == Good
+}
+
+// Log retrieves the signature of every commit within the given revision range,
+// verifying each against a list of allowed keys. A key can either be a GPG key ID,
+// a GPG fingerprint or an SSH key fingerprint (SHA256:...). If no keys are
+// provided, any valid signature will be verified
+func Log(gc *trace.GitClient, revRange string, allowed []string) ([]Signature, error) {
+	format := strings.Join([]string{"%H", "%G?", "%GK", "%GF", "%GP", "%GS"}, "%x1f")
+
+	out, err := gc.Exec("git log '--format=" + format + "' " + revRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs []Signature
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		// Skip any verification problems reported by git alongside each commit
+		fields := strings.Split(line, separator)
+		if len(fields) != 6 {
+			continue
+		}
+
+		status, ok := statuses[fields[1]]
+		if !ok {
+			status = Unverifiable
+		}
+
+		sig := Signature{
+			Hash:        fields[0],
+			Status:      status,
+			Key:         fields[2],
+			Fingerprint: fields[3],
+			Signer:      fields[5],
+		}
+		sig.Verified = verified(sig, allowed, fields[2], fields[3], fields[4])
+		sigs = append(sigs, sig)
+	}
+
+	return sigs, nil
+}
+
+// verified identifies whether a signature was made by an allowed key. A key of
+// unknown validity is only trusted if it has been explicitly allowed
+func verified(sig Signature, allowed []string, keys ...string) bool {
+	switch sig.Status {
+	case Good:
+		return allowedKey(allowed, keys...)
+	case UnknownValidity:
+		return len(allowed) > 0 && allowedKey(allowed, keys...)
+	}
+	return false
+}
+
+func allowedKey(allowed []string, keys ...string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, a := range allowed {
+		a = strings.ReplaceAll(a, " ", "")
+		for _, k := range keys {
+			if k == "" {
+				continue
+			}
+
+			// SSH key fingerprints are base64 encoded and must match exactly
+			if strings.HasPrefix(k, "SHA256:") {
+				if a == k {
+					return true
+				}
+				continue
+			}
+
+			// A long GPG key ID is always a suffix of its fingerprint
+			if len(a) >= 16 && strings.HasSuffix(strings.ToUpper(k), strings.ToUpper(a)) {
+				return true
+			}
+		}
+	}
+	return false
+}
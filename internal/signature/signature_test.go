@@ -0,0 +1,122 @@
+package signature_test
+
+import (
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/gpg"
+	"github.com/gembaadvantage/uplift/internal/signature"
+	"github.com/gembaadvantage/uplift/internal/ssh/sshtest"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_Unsigned(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: an unsigned feature"))
+
+	sigs, err := signature.Log(gitClient(t), "HEAD~1..HEAD", nil)
+	require.NoError(t, err)
+
+	require.Len(t, sigs, 1)
+	assert.Equal(t, gittest.LastCommit(t).Hash, sigs[0].Hash)
+	assert.Equal(t, signature.Unsigned, sigs[0].Status)
+	assert.False(t, sigs[0].Valid())
+	assert.False(t, sigs[0].Verified)
+}
+
+func TestLog_SSH(t *testing.T) {
+	gittest.InitRepository(t)
+	fingerprint := sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "feat: a signed feature")
+
+	sigs, err := signature.Log(gitClient(t), "HEAD~1..HEAD", nil)
+	require.NoError(t, err)
+
+	require.Len(t, sigs, 1)
+	assert.Equal(t, signature.Good, sigs[0].Status)
+	assert.Equal(t, fingerprint, sigs[0].Fingerprint)
+	assert.True(t, sigs[0].Verified)
+}
+
+func TestLog_SSHAllowedKeys(t *testing.T) {
+	gittest.InitRepository(t)
+	fingerprint := sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "feat: a signed feature")
+
+	sigs, err := signature.Log(gitClient(t), "HEAD~1..HEAD", []string{fingerprint})
+	require.NoError(t, err)
+	assert.True(t, sigs[0].Verified)
+
+	sigs, err = signature.Log(gitClient(t), "HEAD~1..HEAD", []string{"SHA256:unknown"})
+	require.NoError(t, err)
+	assert.True(t, sigs[0].Valid())
+	assert.False(t, sigs[0].Verified)
+}
+
+func TestLog_GPGAllowedKeys(t *testing.T) {
+	gittest.InitRepository(t)
+
+	details, err := gpg.ImportKey(gpg.TestKey, gpg.TestPassphrase, gpg.TestFingerprint)
+	require.NoError(t, err)
+	t.Cleanup(func() { gpg.RemoveHome(details.Home) })
+	t.Setenv("GNUPGHOME", details.Home)
+
+	gittest.MustExec(t, "git config user.signingKey "+details.ID)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "feat: a signed feature")
+
+	tests := []struct {
+		name     string
+		allowed  []string
+		verified bool
+	}{
+		{
+			name:     "NoAllowedKeys",
+			verified: false,
+		},
+		{
+			name:     "Fingerprint",
+			allowed:  []string{gpg.TestFingerprint},
+			verified: true,
+		},
+		{
+			name:     "KeyID",
+			allowed:  []string{gpg.TestKeyID},
+			verified: true,
+		},
+		{
+			name:     "ShortKeyID",
+			allowed:  []string{"BD73F690"},
+			verified: false,
+		},
+		{
+			name:     "UnknownKey",
+			allowed:  []string{"AABBCCDDEEFF00112233"},
+			verified: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sigs, err := signature.Log(gitClient(t), "HEAD~1..HEAD", tt.allowed)
+			require.NoError(t, err)
+
+			// An imported key is not trusted by the keyring
+			require.Len(t, sigs, 1)
+			assert.Equal(t, signature.UnknownValidity, sigs[0].Status)
+			assert.False(t, sigs[0].Valid())
+			assert.Equal(t, tt.verified, sigs[0].Verified)
+		})
+	}
+}
+
+func gitClient(t *testing.T) *trace.GitClient {
+	t.Helper()
+
+	gc, err := git.NewClient()
+	require.NoError(t, err)
+	return trace.NewGitClient(gc, nil)
+}
@@ -0,0 +1,86 @@
+package ssh
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// KeyDetails contains details about an imported SSH signing key
+type KeyDetails struct {
+	PrivateKeyPath     string
+	PublicKey          string
+	AllowedSignersPath string
+}
+
+// IsInstalled identifies whether ssh-keygen is installed under the current $PATH.
+// Git depends on ssh-keygen for both signing and verifying with SSH keys
+func IsInstalled() bool {
+	_, err := exec.LookPath("ssh-keygen")
+	return err == nil
+}
+
+// ImportKey writes the private key to a temporary directory, ready for use by
+// git. The public key is derived from the private key and written to an allowed
+// signers file, trusting the key for the given principal. The private key must
+// not be protected by a passphrase
+func ImportKey(key, principal string) (KeyDetails, error) {
+	if !strings.HasPrefix(key, "--") {
+		// Decode base64 string into expected format
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return KeyDetails{}, err
+		}
+		key = string(decoded)
+	}
+
+	// ssh-keygen will reject a private key without a trailing newline
+	if !strings.HasSuffix(key, "\n") {
+		key += "\n"
+	}
+
+	dir, err := os.MkdirTemp("", "uplift-ssh-")
+	if err != nil {
+		return KeyDetails{}, err
+	}
+
+	keyPath := filepath.Join(dir, "signing_key")
+	if err := os.WriteFile(keyPath, []byte(key), 0o600); err != nil {
+		os.RemoveAll(dir)
+		return KeyDetails{}, err
+	}
+
+	pub, err := Run("-y", "-P", "", "-f", keyPath)
+	if err != nil {
+		os.RemoveAll(dir)
+		return KeyDetails{}, err
+	}
+	pub = strings.TrimSpace(pub)
+
+	signersPath := filepath.Join(dir, "allowed_signers")
+	signers := fmt.Sprintf("%s namespaces=\"git\" %s\n", principal, pub)
+	if err := os.WriteFile(signersPath, []byte(signers), 0o600); err != nil {
+		os.RemoveAll(dir)
+		return KeyDetails{}, err
+	}
+
+	return KeyDetails{
+		PrivateKeyPath:     keyPath,
+		PublicKey:          pub,
+		AllowedSignersPath: signersPath,
+	}, nil
+}
+
+// Run executes a ssh-keygen command and returns its output or errors
+func Run(args ...string) (string, error) {
+	cmd := exec.Command("ssh-keygen", args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", errors.New(string(out))
+	}
+	return string(out), nil
+}
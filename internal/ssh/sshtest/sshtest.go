@@ -0,0 +1,50 @@
+// Package sshtest provides helpers for testing SSH signing against a git
+// repository. It must only be imported by tests
+package sshtest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/ssh"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/require"
+)
+
+// GenerateKey generates a new ed25519 SSH key without a passphrase, returning
+// the contents of the private key
+func GenerateKey(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "id_ed25519")
+	_, err := ssh.Run("-q", "-t", "ed25519", "-N", "", "-C", "uplift@test.com", "-f", path)
+	require.NoError(t, err)
+
+	key, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(key)
+}
+
+// ConfigureSigning imports a newly generated SSH key and configures the git
+// repository within the current working directory to sign with it. Signing
+// is not enabled by default. Returns the fingerprint of the imported key
+func ConfigureSigning(t *testing.T) string {
+	t.Helper()
+
+	details, err := ssh.ImportKey(GenerateKey(t), "*")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(filepath.Dir(details.PrivateKeyPath)) })
+
+	gittest.MustExec(t, "git config gpg.format ssh")
+	gittest.MustExec(t, "git config user.signingKey "+details.PrivateKeyPath)
+	gittest.MustExec(t, "git config gpg.ssh.allowedSignersFile "+details.AllowedSignersPath)
+
+	pubPath := filepath.Join(t.TempDir(), "signing_key.pub")
+	require.NoError(t, os.WriteFile(pubPath, []byte(details.PublicKey), 0o600))
+
+	out, err := ssh.Run("-l", "-f", pubPath)
+	require.NoError(t, err)
+	return strings.Fields(out)[1]
+}
@@ -1,8 +1,11 @@
 package bump
 
 import (
+	"fmt"
+
 	"github.com/apex/log"
 	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/diff"
 	"github.com/goreleaser/fileglob"
 	git "github.com/purpleclay/gitz"
 )
@@ -66,6 +69,7 @@ func (t Task) Run(ctx *context.Context) error {
 			if _, err := ctx.GitClient.Stage(git.WithPathSpecs(resolvedBump)); err != nil {
 				return err
 			}
+			ctx.Journal.Staged(resolvedBump)
 			log.WithField("file", resolvedBump).Info("successfully staged file")
 		}
 	}
@@ -77,6 +81,22 @@ func (t Task) Run(ctx *context.Context) error {
 	return nil
 }
 
+// reportDiff either records the diff of a bumped file against the current plan
+// or writes it to stdout, if explicitly requested or running in dry run mode
+func reportDiff(ctx *context.Context, path, before, after string) {
+	if ctx.Plan != nil {
+		ctx.Plan.Files = append(ctx.Plan.Files, context.FileDiff{
+			Path: path,
+			Diff: diff.Unified(path, before, after),
+		})
+		return
+	}
+
+	if ctx.DryRun || ctx.ShowDiff {
+		fmt.Fprint(ctx.Out, diff.Numbered(path, before, after))
+	}
+}
+
 func resolveGlob(pattern string) ([]string, error) {
 	if !fileglob.ContainsMatchers(pattern) {
 		return []string{pattern}, nil
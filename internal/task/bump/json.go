@@ -41,6 +41,14 @@ func jsonBump(ctx *context.Context, path string, bumps []config.JSONBump) (bool,
 		if err != nil {
 			return false, err
 		}
+
+		// A JSON path will only ever replace a single value
+		log.WithFields(log.Fields{
+			"file":     path,
+			"path":     bump.Path,
+			"matches":  1,
+			"replaced": 1,
+		}).Info("json path matched")
 	}
 
 	log.WithFields(log.Fields{
@@ -48,6 +56,7 @@ func jsonBump(ctx *context.Context, path string, bumps []config.JSONBump) (bool,
 		"current": ctx.CurrentVersion.Raw,
 		"next":    ctx.NextVersion.Raw,
 	}).Info("file bumped")
+	reportDiff(ctx, path, string(data), str)
 
 	// Don't make any file changes if part of a dry-run
 	if ctx.DryRun {
@@ -55,5 +64,6 @@ func jsonBump(ctx *context.Context, path string, bumps []config.JSONBump) (bool,
 		return false, nil
 	}
 
+	ctx.Journal.FileWritten(path)
 	return true, os.WriteFile(path, []byte(str), 0o644)
 }
@@ -1,6 +1,7 @@
 package bump
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/gembaadvantage/uplift/internal/config"
@@ -105,6 +106,7 @@ func TestRun_JSONDryRun(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.TempFile(t, "test.json", `{"version": "0.1.0"}`)
 
+	var buf bytes.Buffer
 	ctx := &context.Context{
 		NextVersion: semver.Version{
 			Raw: "0.2.0",
@@ -122,6 +124,7 @@ func TestRun_JSONDryRun(t *testing.T) {
 			},
 		},
 		DryRun: true,
+		Out:    &buf,
 	}
 
 	err := Task{}.Run(ctx)
@@ -129,6 +132,12 @@ func TestRun_JSONDryRun(t *testing.T) {
 
 	actual := ReadFile(t, "test.json")
 	assert.Equal(t, `{"version": "0.1.0"}`, actual)
+	assert.Equal(t, `--- a/test.json
++++ b/test.json
+@@ -1 +1 @@
+1   -{"version": "0.1.0"}
+  1 +{"version": "0.2.0"}
+`, buf.String())
 }
 
 func TestRun_JSONFileDoesNotExist(t *testing.T) {
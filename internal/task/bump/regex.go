@@ -28,7 +28,7 @@ func regexBump(ctx *context.Context, path string, bumps []config.RegexBump) (boo
 			"semver": bump.SemVer,
 		}).Debug("attempting file bump")
 
-		mstr, err := match(bump.Pattern, str)
+		mstr, matches, err := match(bump.Pattern, str)
 		if err != nil {
 			return false, err
 		}
@@ -54,6 +54,31 @@ func regexBump(ctx *context.Context, path string, bumps []config.RegexBump) (boo
 			v = strictSemVer(v)
 		}
 
+		// Identify how many replacements will actually be made, as this can differ
+		// from the expected count within the config. Only copies of the first
+		// match are replaced
+		replaced := strings.Count(str, mstr)
+		if n > 0 && n < replaced {
+			replaced = n
+		}
+
+		log.WithFields(log.Fields{
+			"file":     path,
+			"regex":    bump.Pattern,
+			"matches":  matches,
+			"replaced": replaced,
+		}).Info("regex matched")
+
+		if bump.Count > 0 && matches != bump.Count {
+			log.WithFields(log.Fields{
+				"file":     path,
+				"regex":    bump.Pattern,
+				"count":    bump.Count,
+				"matches":  matches,
+				"replaced": replaced,
+			}).Warn("number of matches differs from expected count")
+		}
+
 		verRpl := semver.Regex.ReplaceAllString(mstr, v)
 		str = strings.Replace(str, mstr, verRpl, n)
 	}
@@ -63,6 +88,7 @@ func regexBump(ctx *context.Context, path string, bumps []config.RegexBump) (boo
 		"current": ctx.CurrentVersion.Raw,
 		"next":    ctx.NextVersion.Raw,
 	}).Info("file bumped")
+	reportDiff(ctx, path, string(data), str)
 
 	// Don't make any file changes if part of a dry-run
 	if ctx.DryRun {
@@ -70,23 +96,26 @@ func regexBump(ctx *context.Context, path string, bumps []config.RegexBump) (boo
 		return false, nil
 	}
 
+	ctx.Journal.FileWritten(path)
 	return true, os.WriteFile(path, []byte(str), 0o644)
 }
 
-func match(pattern string, data string) (string, error) {
+// match returns the first string matched by the pattern, along with the
+// total number of matches within the data
+func match(pattern string, data string) (string, int, error) {
 	verRgx := strings.Replace(pattern, semver.Token, semver.Pattern, 1)
 
 	rgx, err := regexp.Compile(verRgx)
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 
 	m := rgx.FindString(data)
 	if m == "" {
-		return "", errors.New("no version matched in file")
+		return "", 0, errors.New("no version matched in file")
 	}
 
-	return string(m), nil
+	return m, len(rgx.FindAllStringIndex(data, -1)), nil
 }
 
 func strictSemVer(v string) string {
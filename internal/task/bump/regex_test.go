@@ -1,10 +1,14 @@
 package bump
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/cli"
+	"github.com/apex/log/handlers/memory"
 	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/gembaadvantage/uplift/internal/context"
 	"github.com/gembaadvantage/uplift/internal/semver"
@@ -199,6 +203,7 @@ func TestRun_RegexDryRun(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.TempFile(t, "temp.txt", "version: 0.1.0")
 
+	var buf bytes.Buffer
 	ctx := &context.Context{
 		NextVersion: semver.Version{
 			Raw: "0.2.0",
@@ -216,6 +221,7 @@ func TestRun_RegexDryRun(t *testing.T) {
 			},
 		},
 		DryRun: true,
+		Out:    &buf,
 	}
 
 	err := Task{}.Run(ctx)
@@ -223,6 +229,83 @@ func TestRun_RegexDryRun(t *testing.T) {
 
 	actual := ReadFile(t, "temp.txt")
 	assert.Equal(t, "version: 0.1.0", actual)
+	assert.Equal(t, `--- a/temp.txt
++++ b/temp.txt
+@@ -1 +1 @@
+1   -version: 0.1.0
+  1 +version: 0.2.0
+`, buf.String())
+}
+
+func TestRun_RegexShowDiff(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "temp.txt", "version: 0.1.0")
+
+	var buf bytes.Buffer
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "0.2.0",
+		},
+		Config: config.Uplift{
+			Bumps: []config.Bump{
+				{
+					File: "temp.txt",
+					Regex: []config.RegexBump{
+						{
+							Pattern: "version: $VERSION",
+						},
+					},
+				},
+			},
+		},
+		ShowDiff: true,
+		NoStage:  true,
+		Out:      &buf,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	actual := ReadFile(t, "temp.txt")
+	assert.Equal(t, "version: 0.2.0", actual)
+	assert.Contains(t, buf.String(), "  1 +version: 0.2.0")
+}
+
+func TestRun_RegexDryRunRecordsPlan(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "temp.txt", "version: 0.1.0")
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "0.2.0",
+		},
+		Config: config.Uplift{
+			Bumps: []config.Bump{
+				{
+					File: "temp.txt",
+					Regex: []config.RegexBump{
+						{
+							Pattern: "version: $VERSION",
+						},
+					},
+				},
+			},
+		},
+		DryRun: true,
+		Plan:   &context.Plan{},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, ctx.Plan.Files, 1)
+	assert.Equal(t, "temp.txt", ctx.Plan.Files[0].Path)
+	assert.Equal(t, `--- a/temp.txt
++++ b/temp.txt
+@@ -1 +1 @@
+-version: 0.1.0
++version: 0.2.0
+`, ctx.Plan.Files[0].Diff)
 }
 
 func TestRun_RegexFileDoesNotExist(t *testing.T) {
@@ -532,3 +615,89 @@ description: This is a test chart
 version: 0.1.1
 appVersion: v0.1.1`, actual)
 }
+
+func TestRun_RegexCountMismatchWarns(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "temp.txt", "version: 0.1.0\nversion: 0.1.0\nversion: 0.1.0")
+
+	handler := memory.New()
+	log.SetHandler(handler)
+	t.Cleanup(func() { log.SetHandler(cli.Default) })
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "0.2.0",
+		},
+		Config: config.Uplift{
+			Bumps: []config.Bump{
+				{
+					File: "temp.txt",
+					Regex: []config.RegexBump{
+						{
+							Pattern: "version: $VERSION",
+							Count:   2,
+						},
+					},
+				},
+			},
+		},
+		NoStage: true,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	var warning *log.Entry
+	for _, e := range handler.Entries {
+		if e.Level == log.WarnLevel {
+			warning = e
+		}
+	}
+	require.NotNil(t, warning)
+	assert.Equal(t, "number of matches differs from expected count", warning.Message)
+	assert.Equal(t, 3, warning.Fields["matches"])
+	assert.Equal(t, 2, warning.Fields["replaced"])
+}
+
+func TestRun_RegexCountDifferentMatches(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.TempFile(t, "temp.txt", "version: 0.1.0\nversion: 0.0.9")
+
+	handler := memory.New()
+	log.SetHandler(handler)
+	t.Cleanup(func() { log.SetHandler(cli.Default) })
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "0.2.0",
+		},
+		Config: config.Uplift{
+			Bumps: []config.Bump{
+				{
+					File: "temp.txt",
+					Regex: []config.RegexBump{
+						{
+							Pattern: "version: $VERSION",
+							Count:   2,
+						},
+					},
+				},
+			},
+		},
+		NoStage: true,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	var matched *log.Entry
+	for _, e := range handler.Entries {
+		assert.NotEqual(t, log.WarnLevel, e.Level)
+		if e.Message == "regex matched" {
+			matched = e
+		}
+	}
+	require.NotNil(t, matched)
+	assert.Equal(t, 2, matched.Fields["matches"])
+	assert.Equal(t, 1, matched.Fields["replaced"])
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/apex/log"
 	"github.com/gembaadvantage/uplift/internal/context"
 	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/signature"
 	git "github.com/purpleclay/gitz"
 )
 
@@ -52,7 +53,12 @@ var (
 type release struct {
 	SCM     context.SCM
 	Tag     tagEntry
-	Changes []git.LogEntry
+	Changes []change
+}
+
+type change struct {
+	git.LogEntry
+	Signature signature.Signature
 }
 
 type tagEntry struct {
@@ -97,11 +103,15 @@ func (t Task) Run(ctx *context.Context) error {
 		if _, err := ctx.GitClient.Tag(ctx.NextVersion.Raw, git.WithLocalOnly()); err != nil {
 			return err
 		}
+		ctx.Journal.Tagged(ctx.NextVersion.Raw)
+
 		defer func() {
 			log.Info("removing pre-tag after changelog creation")
 			if _, err := ctx.GitClient.DeleteTag(ctx.NextVersion.Raw, git.WithLocalDelete()); err != nil {
 				log.WithError(err).Error("failed to delete pre-tag")
+				return
 			}
+			ctx.Journal.Untagged(ctx.NextVersion.Raw)
 		}()
 	}
 
@@ -122,11 +132,6 @@ func (t Task) Run(ctx *context.Context) error {
 		return nil
 	}
 
-	if ctx.DryRun {
-		log.Info("skip writing to changelog in dry run mode")
-		return nil
-	}
-
 	if ctx.Changelog.Multiline {
 		log.Info("formatting multiline messages for changelog")
 		for i := range rels {
@@ -159,15 +164,28 @@ func (t Task) Run(ctx *context.Context) error {
 		}
 	}
 
-	if ctx.Changelog.DiffOnly {
-		diff, err := diffChangelog(rels)
-		if err != nil {
-			return err
+	diff, err := diffChangelog(rels)
+	if err != nil {
+		return err
+	}
+	ctx.ReleaseNotes = diff
+
+	if ctx.DryRun {
+		if ctx.Plan != nil {
+			ctx.Plan.Changelog = diff
 		}
+
+		log.Info("skip writing to changelog in dry run mode")
+		return nil
+	}
+
+	if ctx.Changelog.DiffOnly {
 		fmt.Fprint(ctx.Out, diff)
 		return nil
 	}
 
+	ctx.Journal.FileWritten(MarkdownFile)
+
 	var chgErr error
 	if noChangelogExists() || ctx.Changelog.All {
 		chgErr = newChangelog(rels)
@@ -184,8 +202,12 @@ func (t Task) Run(ctx *context.Context) error {
 	}
 
 	log.Debug("staging CHANGELOG.md")
-	_, err := ctx.GitClient.Stage(git.WithPathSpecs(MarkdownFile))
-	return err
+	if _, err := ctx.GitClient.Stage(git.WithPathSpecs(MarkdownFile)); err != nil {
+		return err
+	}
+	ctx.Journal.Staged(MarkdownFile)
+
+	return nil
 }
 
 func changelogRelease(ctx *context.Context) ([]release, error) {
@@ -263,12 +285,17 @@ func changelogRelease(ctx *context.Context) ([]release, error) {
 		reverse(ents)
 	}
 
+	chgs, err := withSignatures(ctx, ents, next, prev)
+	if err != nil {
+		return []release{}, err
+	}
+
 	tagDetails, _ := ctx.GitClient.ShowTags(ctx.NextVersion.Raw)
 	return []release{
 		{
 			SCM:     ctx.SCM,
 			Tag:     extractTagEntry(tagDetails[ctx.NextVersion.Raw]),
-			Changes: ents,
+			Changes: chgs,
 		},
 	}, nil
 }
@@ -368,16 +395,53 @@ func changelogReleases(ctx *context.Context) ([]release, error) {
 			reverse(ents)
 		}
 
+		chgs, err := withSignatures(ctx, ents, tag.Ref, nextTag)
+		if err != nil {
+			return []release{}, err
+		}
+
 		rels = append(rels, release{
 			SCM:     ctx.SCM,
 			Tag:     tag,
-			Changes: ents,
+			Changes: chgs,
 		})
 	}
 
 	return rels, nil
 }
 
+// withSignatures wraps each log entry as a change. The signature of each change
+// is only retrieved when signature verification is enabled
+func withSignatures(ctx *context.Context, ents []git.LogEntry, from, to string) ([]change, error) {
+	chgs := make([]change, 0, len(ents))
+	if ctx.VerifySignatures == nil {
+		for _, ent := range ents {
+			chgs = append(chgs, change{LogEntry: ent})
+		}
+		return chgs, nil
+	}
+
+	revRange := from
+	if to != "" {
+		revRange = to + ".." + from
+	}
+
+	sigs, err := signature.Log(ctx.GitClient, revRange, ctx.VerifySignatures.AllowedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	sigsByHash := make(map[string]signature.Signature, len(sigs))
+	for _, sig := range sigs {
+		sigsByHash[sig.Hash] = sig
+	}
+
+	for _, ent := range ents {
+		chgs = append(chgs, change{LogEntry: ent, Signature: sigsByHash[ent.Hash]})
+	}
+	return chgs, nil
+}
+
 func noChangelogExists() bool {
 	_, err := os.Stat(MarkdownFile)
 	return os.IsNotExist(err)
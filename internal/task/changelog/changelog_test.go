@@ -10,6 +10,7 @@ import (
 
 	"github.com/gembaadvantage/uplift/internal/context"
 	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/ssh/sshtest"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -314,6 +315,41 @@ first commit
 
 	assert.False(t, changelogExists(t))
 	assert.Equal(t, expected, buf.String())
+	assert.Equal(t, expected, ctx.ReleaseNotes)
+}
+
+func TestRun_DryRunRecordsPlan(t *testing.T) {
+	log := `(tag: 1.1.0) second commit
+first commit
+(tag: 1.0.0) won't appear in changelog`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	hashes := hashLookup(t, gittest.Log(t))
+
+	ctx := &context.Context{
+		DryRun: true,
+		Plan:   &context.Plan{},
+		CurrentVersion: semver.Version{
+			Raw: "1.0.0",
+		},
+		NextVersion: semver.Version{
+			Raw: "1.1.0",
+		},
+		SCM: context.SCM{
+			Provider: context.Unrecognised,
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf(`## 1.1.0 - %s
+
+- %s second commit
+- %s first commit
+`, changelogDate(t), hashes["second commit"], hashes["first commit"])
+
+	assert.False(t, changelogExists(t))
+	assert.Equal(t, expected, ctx.Plan.Changelog)
 }
 
 func TestRun_NoLogEntries(t *testing.T) {
@@ -1005,3 +1041,50 @@ feat: second commit
 
 	assert.Equal(t, expected, buf.String())
 }
+
+func TestRun_VerifiedSignatures(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("(tag: 1.0.0) won't appear in changelog"))
+	gittest.CommitEmpty(t, "unsigned commit")
+
+	sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git -c commit.gpgsign=true commit --allow-empty -m 'signed commit'")
+	gittest.Tag(t, "1.1.0")
+	hashes := hashLookup(t, gittest.Log(t))
+
+	var buf bytes.Buffer
+	ctx := &context.Context{
+		Out: &buf,
+		Changelog: context.Changelog{
+			DiffOnly: true,
+		},
+		CurrentVersion: semver.Version{
+			Raw: "1.0.0",
+		},
+		NextVersion: semver.Version{
+			Raw: "1.1.0",
+		},
+		SCM: context.SCM{
+			Provider: context.Unrecognised,
+		},
+		VerifySignatures: &context.VerifySignatures{},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	// The default templates are unchanged by signature verification
+	expected := fmt.Sprintf(`## 1.1.0 - %s
+
+- %s signed commit
+- %s unsigned commit
+`, changelogDate(t), hashes["signed commit"], hashes["unsigned commit"])
+
+	assert.Equal(t, expected, buf.String())
+
+	rels, err := changelogRelease(ctx)
+	require.NoError(t, err)
+	require.Len(t, rels, 1)
+	require.Len(t, rels[0].Changes, 2)
+	assert.True(t, rels[0].Changes[0].Signature.Verified)
+	assert.False(t, rels[0].Changes[1].Signature.Verified)
+}
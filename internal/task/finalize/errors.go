@@ -0,0 +1,19 @@
+package finalize
+
+import "fmt"
+
+// ErrNotMerged is raised when finalizing a release before its pull request
+// has been merged
+type ErrNotMerged struct {
+	url    string
+	branch string
+}
+
+// Error returns a formatted message of the current error
+func (e ErrNotMerged) Error() string {
+	return fmt.Sprintf(`uplift cannot finalize the release as the pull request for branch %s has not
+been merged. Please merge the pull request before retrying:
+
+%s
+`, e.branch, e.url)
+}
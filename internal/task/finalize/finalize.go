@@ -0,0 +1,56 @@
+package finalize
+
+import (
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/pullrequest"
+)
+
+// Task for finalizing a release that was published through a pull request.
+// Once merged, the merge commit of the pull request will be tagged
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "finalizing pull request release"
+}
+
+// Skip running the task if there is no release to finalize
+func (t Task) Skip(ctx *context.Context) bool {
+	return ctx.PullRequest == nil || ctx.NoVersionChanged
+}
+
+// Run the task
+func (t Task) Run(ctx *context.Context) error {
+	branch := ctx.PullRequest.Prefix + ctx.NextVersion.Raw
+
+	client, err := pullrequest.NewClient(ctx.SCM)
+	if err != nil {
+		return err
+	}
+
+	pr, err := client.Find(branch)
+	if err != nil {
+		return err
+	}
+
+	if !pr.Merged {
+		return ErrNotMerged{url: pr.URL, branch: branch}
+	}
+
+	log.WithFields(log.Fields{
+		"number": pr.Number,
+		"commit": pr.MergeCommit,
+	}).Info("pull request merged")
+
+	// Ensure the merge commit exists locally before it is tagged
+	if _, err := ctx.GitClient.Fetch(); err != nil {
+		return err
+	}
+
+	ctx.PullRequest.Branch = branch
+	ctx.PullRequest.Number = pr.Number
+	ctx.PullRequest.URL = pr.URL
+	ctx.TagCommit = pr.MergeCommit
+	return nil
+}
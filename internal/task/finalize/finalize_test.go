@@ -0,0 +1,94 @@
+package finalize
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/pullrequest"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "finalizing pull request release", Task{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	assert.True(t, Task{}.Skip(&context.Context{}))
+	assert.True(t, Task{}.Skip(&context.Context{
+		PullRequest:      &context.PullRequest{},
+		NoVersionChanged: true,
+	}))
+}
+
+func giteaServer(t *testing.T, response string) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/pulls", r.URL.Path)
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv(pullrequest.GiteaTokenEnv, "token")
+
+	return srv.URL
+}
+
+func TestRun(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: a new feature"))
+	hash := gittest.LastCommit(t).Hash
+
+	apiURL := giteaServer(t, fmt.Sprintf(`[{
+		"number": 4,
+		"html_url": "https://gitea.com/owner/repo/pulls/4",
+		"merged": true,
+		"merge_commit_sha": "%s",
+		"head": {"ref": "uplift/release-v0.1.0"}
+	}]`, hash))
+
+	gitc, _ := git.NewClient()
+	ctx := &context.Context{
+		GitClient:   trace.NewGitClient(gitc, nil),
+		NextVersion: semver.Version{Raw: "v0.1.0"},
+		PullRequest: &context.PullRequest{Prefix: "uplift/release-"},
+		SCM:         context.SCM{Provider: context.Gitea, APIURL: apiURL, Repo: "owner/repo"},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, hash, ctx.TagCommit)
+	assert.Equal(t, 4, ctx.PullRequest.Number)
+	assert.Equal(t, "uplift/release-v0.1.0", ctx.PullRequest.Branch)
+}
+
+func TestRun_NotMerged(t *testing.T) {
+	gittest.InitRepository(t)
+
+	apiURL := giteaServer(t, `[{
+		"number": 4,
+		"html_url": "https://gitea.com/owner/repo/pulls/4",
+		"merged": false,
+		"head": {"ref": "uplift/release-v0.1.0"}
+	}]`)
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{Raw: "v0.1.0"},
+		PullRequest: &context.PullRequest{Prefix: "uplift/release-"},
+		SCM:         context.SCM{Provider: context.Gitea, APIURL: apiURL, Repo: "owner/repo"},
+	}
+
+	err := Task{}.Run(ctx)
+
+	var notMerged ErrNotMerged
+	require.ErrorAs(t, err, &notMerged)
+	assert.Contains(t, err.Error(), "https://gitea.com/owner/repo/pulls/4")
+	assert.Empty(t, ctx.TagCommit)
+}
@@ -1,10 +1,30 @@
 package gitcommit
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/apex/log"
 	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/gembaadvantage/uplift/internal/context"
 	git "github.com/purpleclay/gitz"
+	"mvdan.cc/sh/v3/syntax"
+)
+
+const (
+	// FailStrategy fails the release if the remote branch is ahead of the
+	// local branch when pushing the release commit
+	FailStrategy = "fail"
+
+	// RebaseStrategy rebases the release commit onto the remote branch if
+	// it is ahead of the local branch when pushing the release commit
+	RebaseStrategy = "rebase"
+
+	// DefaultRebaseRetries defines the number of times the release commit
+	// will be rebased before the release fails
+	DefaultRebaseRetries = 3
 )
 
 // Task for committing all staged changes and optionally pushing
@@ -33,6 +53,11 @@ func (t Task) Run(ctx *context.Context) error {
 		return nil
 	}
 
+	parent, err := ctx.GitClient.Exec("git rev-parse HEAD")
+	if err != nil {
+		return err
+	}
+
 	log.Debug("attempting to commit changes")
 	if _, err := ctx.GitClient.Commit(ctx.CommitDetails.Message,
 		git.WithCommitConfig("user.name", ctx.CommitDetails.Author.Name,
@@ -41,18 +66,188 @@ func (t Task) Run(ctx *context.Context) error {
 	}
 	log.Info("staged changes committed")
 
+	hash, err := ctx.GitClient.Exec("git rev-parse HEAD")
+	if err != nil {
+		return err
+	}
+	ctx.Journal.Committed(hash, parent)
+
 	if ctx.NoPush {
 		log.Warn("skipping push of commit to remote")
 		return nil
 	}
 
-	log.Info("pushing commit to remote")
-	var pushOpts []string
-	if ctx.Config.Git != nil {
-		pushOpts = filterPushOptions(ctx.Config.Git.PushOptions)
+	branch, err := ctx.GitClient.Exec("git branch --show-current")
+	if err != nil {
+		return err
+	}
+
+	rebases := 0
+	var pushErr error
+	for {
+		ahead, err := remoteAhead(ctx, branch)
+		if err != nil {
+			return err
+		}
+
+		if ahead > 0 {
+			strategy, retries := remoteAheadConfig(ctx)
+			if strategy != RebaseStrategy {
+				return ErrRemoteAhead{branch: branch, commits: ahead}
+			}
+
+			if rebases == retries {
+				return ErrRebaseRetriesExceeded{branch: branch, retries: retries}
+			}
+
+			if hash, err = rebase(ctx, branch); err != nil {
+				return err
+			}
+			rebases++
+		} else if pushErr != nil {
+			// The push was rejected for a reason other than the remote being ahead
+			return pushErr
+		}
+
+		if ctx.AtomicPush {
+			log.WithField("branch", branch).Info("deferring push of commit for atomic push")
+			ctx.PendingPush.Branch = branch
+			return nil
+		}
+
+		log.Info("pushing commit to remote")
+		var pushOpts []string
+		if ctx.Config.Git != nil {
+			pushOpts = filterPushOptions(ctx.Config.Git.PushOptions)
+		}
+
+		_, pushErr = ctx.GitClient.Push(git.WithPushOptions(pushOpts...))
+		if pushErr == nil {
+			break
+		}
+
+		// Without a branch, there is no way of detecting if the remote is ahead
+		if branch == "" {
+			return pushErr
+		}
+		log.WithError(pushErr).Warn("push of commit rejected, checking if remote is ahead")
+	}
+	ctx.Journal.Pushed(hash)
+
+	return nil
+}
+
+// remoteAhead fetches the latest changes from the remote and returns the number of
+// commits on the remote branch that are not within the local branch
+func remoteAhead(ctx *context.Context, branch string) (int, error) {
+	// Detached HEAD, nothing to compare against
+	if branch == "" {
+		return 0, nil
+	}
+
+	log.WithField("branch", branch).Debug("checking if remote is ahead")
+
+	// The branch may not yet exist on the remote, such as a newly created
+	// release branch, in which case there is nothing to fetch
+	heads, err := ctx.GitClient.Exec("git ls-remote --heads origin refs/heads/" + branch)
+	if err != nil {
+		return 0, err
+	}
+
+	if heads == "" {
+		log.WithField("branch", branch).Debug("branch does not exist on remote")
+		return 0, nil
+	}
+
+	if _, err := ctx.GitClient.Fetch(git.WithFetchRefSpecs(branch)); err != nil {
+		return 0, err
+	}
+
+	remote := "origin/" + branch
+
+	out, err := ctx.GitClient.Exec(fmt.Sprintf("git rev-list --count HEAD..%s", remote))
+	if err != nil {
+		return 0, err
+	}
+
+	ahead, err := strconv.Atoi(out)
+	if err != nil {
+		return 0, err
+	}
+
+	if ahead > 0 {
+		log.WithFields(log.Fields{
+			"branch":  branch,
+			"commits": ahead,
+		}).Warn("remote branch is ahead")
+	}
+	return ahead, nil
+}
+
+// rebase the release commit onto the remote branch, returning the hash of the
+// rebased commit. A failed rebase is always aborted
+func rebase(ctx *context.Context, branch string) (string, error) {
+	remote := "origin/" + branch
+	parent, err := ctx.GitClient.Exec("git rev-parse " + remote)
+	if err != nil {
+		return "", err
+	}
+
+	// Ensure the rebased commit is committed by the same author as the release commit
+	var cmd strings.Builder
+	cmd.WriteString("git")
+	if ctx.CommitDetails.Author.Name != "" {
+		for _, c := range []string{
+			"user.name=" + ctx.CommitDetails.Author.Name,
+			"user.email=" + ctx.CommitDetails.Author.Email,
+		} {
+			quoted, err := syntax.Quote(c, syntax.LangBash)
+			if err != nil {
+				return "", err
+			}
+			cmd.WriteString(" -c " + quoted)
+		}
+	}
+	cmd.WriteString(" rebase --autostash " + remote)
+
+	log.WithField("onto", remote).Info("rebasing release commit")
+	if _, err := ctx.GitClient.Exec(cmd.String()); err != nil {
+		if _, abortErr := ctx.GitClient.Exec("git rebase --abort"); abortErr != nil {
+			log.WithError(abortErr).Warn("failed to abort rebase")
+		}
+
+		out := err.Error()
+		var execErr git.ErrGitExecCommand
+		if errors.As(err, &execErr) {
+			out = execErr.Out
+		}
+		return "", ErrRebaseFailed{branch: branch, out: out}
+	}
+
+	hash, err := ctx.GitClient.Exec("git rev-parse HEAD")
+	if err != nil {
+		return "", err
+	}
+	ctx.Journal.Rebased(hash, parent)
+
+	return hash, nil
+}
+
+func remoteAheadConfig(ctx *context.Context) (string, int) {
+	if ctx.Config.Git == nil || ctx.Config.Git.RemoteAhead == nil {
+		return FailStrategy, 0
+	}
+
+	cfg := ctx.Config.Git.RemoteAhead
+	if cfg.Strategy != RebaseStrategy {
+		return FailStrategy, 0
+	}
+
+	retries := cfg.Retries
+	if retries == 0 {
+		retries = DefaultRebaseRetries
 	}
-	_, err = ctx.GitClient.Push(git.WithPushOptions(pushOpts...))
-	return err
+	return RebaseStrategy, retries
 }
 
 func filterPushOptions(options []config.GitPushOption) []string {
@@ -1,6 +1,7 @@
 package gitcommit
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/gembaadvantage/uplift/internal/config"
@@ -97,3 +98,189 @@ func TestFilterPushOptions(t *testing.T) {
 	assert.Len(t, filtered, 2)
 	assert.Equal(t, []string{"option1", "option3"}, filtered)
 }
+
+func TestRun_AtomicPushDefersPush(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+
+	ctx := &context.Context{
+		CommitDetails: git.CommitDetails{
+			Author: git.Person{
+				Name:  "uplift",
+				Email: "uplift@test.com",
+			},
+			Message: "test commit",
+		},
+		AtomicPush: true,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.DefaultBranch, ctx.PendingPush.Branch)
+	assert.Equal(t, "test commit", gittest.LastCommit(t).Message)
+
+	remoteLog := gittest.RemoteLog(t)
+	assert.NotEqual(t, "test commit", remoteLog[0].Message)
+}
+
+func TestRun_RemoteAhead(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithRemoteLog("(main, origin/main) fix: a remote only fix"),
+		gittest.WithStagedFiles("test.txt"))
+
+	err := Task{}.Run(&context.Context{
+		CommitDetails: git.CommitDetails{
+			Message: "test commit",
+		},
+	})
+
+	var aheadErr ErrRemoteAhead
+	require.ErrorAs(t, err, &aheadErr)
+	assert.Equal(t, 1, aheadErr.commits)
+}
+
+func TestRun_BranchNotOnRemote(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git checkout -b feature/new-branch")
+	gittest.TempFile(t, "test.txt", "version: 0.1.0")
+	gittest.StageFile(t, "test.txt")
+
+	err := Task{}.Run(&context.Context{
+		CommitDetails: git.CommitDetails{
+			Author: git.Person{
+				Name:  "uplift",
+				Email: "uplift@test.com",
+			},
+			Message: "test commit",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, gittest.LastCommit(t).Hash,
+		gittest.MustExec(t, "git ls-remote --heads origin refs/heads/feature/new-branch | cut -f1"))
+}
+
+func TestRun_RemoteAheadRebase(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithRemoteLog("(main, origin/main) fix: a remote only fix"),
+		gittest.WithStagedFiles("test.txt"))
+
+	ctx := &context.Context{
+		CommitDetails: git.CommitDetails{
+			Author: git.Person{
+				Name:  "uplift",
+				Email: "uplift@test.com",
+			},
+			Message: "test commit",
+		},
+		Config: config.Uplift{
+			Git: &config.Git{
+				RemoteAhead: &config.GitRemoteAhead{
+					Strategy: RebaseStrategy,
+				},
+			},
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	rlog := gittest.RemoteLog(t)
+	require.Len(t, rlog, 3)
+	assert.Equal(t, "test commit", rlog[0].Message)
+	assert.Equal(t, "fix: a remote only fix", rlog[1].Message)
+	assert.Equal(t, gittest.LastCommit(t).Hash, gittest.MustExec(t, "git rev-parse origin/main"))
+}
+
+func TestRun_RemoteAheadRebaseQuotesAuthor(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithRemoteLog("(main, origin/main) fix: a remote only fix"),
+		gittest.WithStagedFiles("test.txt"))
+
+	ctx := &context.Context{
+		CommitDetails: git.CommitDetails{
+			Author: git.Person{
+				Name:  `Joe "$HOME" Bloggs`,
+				Email: "joe.bloggs@test.com",
+			},
+			Message: "test commit",
+		},
+		Config: config.Uplift{
+			Git: &config.Git{
+				RemoteAhead: &config.GitRemoteAhead{
+					Strategy: RebaseStrategy,
+				},
+			},
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	committer := gittest.MustExec(t, "git log -1 --format='%cn <%ce>'")
+	assert.Equal(t, `Joe "$HOME" Bloggs <joe.bloggs@test.com>`, strings.TrimSpace(committer))
+}
+
+func TestRun_RemoteAheadRebaseConflict(t *testing.T) {
+	gittest.InitRepository(t,
+		gittest.WithRemoteLog("(main, origin/main) fix: a remote only fix"),
+		gittest.WithStagedFiles("README.md"))
+
+	ctx := &context.Context{
+		CommitDetails: git.CommitDetails{
+			Message: "test commit",
+		},
+		Config: config.Uplift{
+			Git: &config.Git{
+				RemoteAhead: &config.GitRemoteAhead{
+					Strategy: RebaseStrategy,
+				},
+			},
+		},
+	}
+
+	err := Task{}.Run(ctx)
+
+	var rebaseErr ErrRebaseFailed
+	require.ErrorAs(t, err, &rebaseErr)
+	assert.Equal(t, "test commit", gittest.LastCommit(t).Message)
+	assert.Empty(t, gittest.PorcelainStatus(t))
+}
+
+func TestRemoteAheadConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *config.Git
+		strategy string
+		retries  int
+	}{
+		{
+			name:     "NotConfigured",
+			strategy: FailStrategy,
+		},
+		{
+			name:     "Fail",
+			cfg:      &config.Git{RemoteAhead: &config.GitRemoteAhead{Strategy: FailStrategy}},
+			strategy: FailStrategy,
+		},
+		{
+			name:     "RebaseDefaultRetries",
+			cfg:      &config.Git{RemoteAhead: &config.GitRemoteAhead{Strategy: RebaseStrategy}},
+			strategy: RebaseStrategy,
+			retries:  DefaultRebaseRetries,
+		},
+		{
+			name:     "RebaseWithRetries",
+			cfg:      &config.Git{RemoteAhead: &config.GitRemoteAhead{Strategy: RebaseStrategy, Retries: 5}},
+			strategy: RebaseStrategy,
+			retries:  5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, retries := remoteAheadConfig(&context.Context{Config: config.Uplift{Git: tt.cfg}})
+			assert.Equal(t, tt.strategy, strategy)
+			assert.Equal(t, tt.retries, retries)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package gitcommit
+
+import "fmt"
+
+// ErrRemoteAhead is raised when the remote branch contains commits that are not
+// within the local branch, preventing the release commit from being pushed
+type ErrRemoteAhead struct {
+	branch  string
+	commits int
+}
+
+// Error returns a formatted message of the current error
+func (e ErrRemoteAhead) Error() string {
+	return fmt.Sprintf(`uplift cannot push the release commit as the remote branch origin/%s is %d commit(s)
+ahead of the local branch. Changes were most likely merged while the release was in
+progress. To automatically rebase the release commit onto the remote branch, set
+git.remoteAhead.strategy to rebase within your config.
+
+For further details visit: https://upliftci.dev/faq/gitremoteahead
+`, e.branch, e.commits)
+}
+
+// ErrRebaseFailed is raised when the release commit could not be rebased onto the
+// remote branch. Any rebase in progress will have been aborted
+type ErrRebaseFailed struct {
+	branch string
+	out    string
+}
+
+// Error returns a formatted message of the current error
+func (e ErrRebaseFailed) Error() string {
+	return fmt.Sprintf(`uplift failed to rebase the release commit onto the remote branch origin/%s. The
+rebase has been aborted:
+
+%s
+
+For further details visit: https://upliftci.dev/faq/gitremoteahead
+`, e.branch, e.out)
+}
+
+// ErrRebaseRetriesExceeded is raised when the remote branch continues to move
+// ahead of the release commit after the configured number of rebases
+type ErrRebaseRetriesExceeded struct {
+	branch  string
+	retries int
+}
+
+// Error returns a formatted message of the current error
+func (e ErrRebaseRetriesExceeded) Error() string {
+	return fmt.Sprintf(`uplift could not push the release commit after rebasing onto the remote branch
+origin/%s %d time(s). The remote branch is still ahead of the local branch. Consider
+increasing git.remoteAhead.retries within your config.
+
+For further details visit: https://upliftci.dev/faq/gitremoteahead
+`, e.branch, e.retries)
+}
@@ -0,0 +1,39 @@
+package gitpush
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrPushRejected is raised when the remote rejects an atomic push. As the push
+// is atomic, none of the references will have been updated on the remote
+type ErrPushRejected struct {
+	refs []string
+	out  string
+}
+
+// Error returns a formatted message of the current error
+func (e ErrPushRejected) Error() string {
+	return fmt.Sprintf(`uplift could not push [%s] as the remote rejected the atomic push. No references
+have been updated on the remote. This typically happens when the remote contains
+changes that are not within your local branch:
+
+%s
+`, strings.Join(e.refs, " "), e.out)
+}
+
+// ErrTargetedPushOptions is raised when an atomic push contains both a branch
+// and tag, but a push option has been configured to skip one of them. Push
+// options are sent once per push and cannot be honoured per reference
+type ErrTargetedPushOptions struct {
+	options []string
+}
+
+// Error returns a formatted message of the current error
+func (e ErrTargetedPushOptions) Error() string {
+	return fmt.Sprintf(`uplift cannot push both the branch and tag atomically, as the push options
+[%s] skip either the branch or tag. Push options are sent once per push and
+cannot be targeted at an individual reference within an atomic push. Either
+remove skipBranch and skipTag from these push options, or disable git.atomicPush`,
+		strings.Join(e.options, " "))
+}
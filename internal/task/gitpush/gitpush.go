@@ -0,0 +1,107 @@
+package gitpush
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+	git "github.com/purpleclay/gitz"
+)
+
+// Task for pushing all deferred references to a git remote within
+// a single atomic push
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "pushing changes atomically"
+}
+
+// Skip running the task if atomic pushes are disabled or there is nothing to push
+func (t Task) Skip(ctx *context.Context) bool {
+	return !ctx.AtomicPush || ctx.DryRun || ctx.NoPush ||
+		(ctx.PendingPush.Branch == "" && ctx.PendingPush.Tag == "")
+}
+
+// Run the task, pushing both the branch and tag to the remote using git push --atomic.
+// Either all references are updated on the remote, or none are
+func (t Task) Run(ctx *context.Context) error {
+	refs := make([]string, 0, 2)
+	if ctx.PendingPush.Branch != "" {
+		refs = append(refs, ctx.PendingPush.Branch)
+	}
+	if ctx.PendingPush.Tag != "" {
+		refs = append(refs, ctx.PendingPush.Tag)
+	}
+
+	var pushOpts []string
+	if ctx.Config.Git != nil {
+		var err error
+		if pushOpts, err = filterPushOptions(ctx.Config.Git.PushOptions, ctx.PendingPush); err != nil {
+			return err
+		}
+	}
+
+	var cmd strings.Builder
+	cmd.WriteString("git push --atomic")
+	for _, opt := range pushOpts {
+		cmd.WriteString(" --push-option=" + opt)
+	}
+	cmd.WriteString(" origin " + strings.Join(refs, " "))
+
+	log.WithField("refs", strings.Join(refs, " ")).Info("pushing to remote")
+	_, err := ctx.GitClient.Exec(cmd.String())
+	if err != nil {
+		var execErr git.ErrGitExecCommand
+		if errors.As(err, &execErr) && rejected(execErr.Out) {
+			return ErrPushRejected{refs: refs, out: execErr.Out}
+		}
+		return err
+	}
+
+	for _, ref := range refs {
+		ctx.Journal.Pushed(ref)
+	}
+	ctx.PendingPush = context.PendingPush{}
+
+	return nil
+}
+
+// filterPushOptions only retains push options that apply to every reference
+// within the atomic push. As push options are sent once per push, they cannot
+// be targeted at an individual reference. Pushing both a branch and tag with
+// any option that skips either of them would change which options reach each
+// reference, and is rejected
+func filterPushOptions(options []config.GitPushOption, pending context.PendingPush) ([]string, error) {
+	both := pending.Branch != "" && pending.Tag != ""
+
+	filtered := []string{}
+	var targeted []string
+	for _, opt := range options {
+		if both && (opt.SkipBranch || opt.SkipTag) {
+			targeted = append(targeted, opt.Option)
+			continue
+		}
+
+		if (pending.Branch != "" && opt.SkipBranch) || (pending.Tag != "" && opt.SkipTag) {
+			log.WithField("option", opt.Option).Debug("skipping push option not applicable to ref")
+			continue
+		}
+
+		log.WithField("option", opt.Option).Debug("with push option")
+		filtered = append(filtered, opt.Option)
+	}
+
+	if len(targeted) > 0 {
+		return nil, ErrTargetedPushOptions{options: targeted}
+	}
+	return filtered, nil
+}
+
+func rejected(out string) bool {
+	return strings.Contains(out, "[rejected]") ||
+		strings.Contains(out, "[remote rejected]") ||
+		strings.Contains(out, "atomic push failed")
+}
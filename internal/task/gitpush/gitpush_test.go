@@ -0,0 +1,202 @@
+package gitpush
+
+import (
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/journal"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "pushing changes atomically", Task{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  *context.Context
+	}{
+		{
+			name: "AtomicPushDisabled",
+			ctx: &context.Context{
+				PendingPush: context.PendingPush{Tag: "v0.1.0"},
+			},
+		},
+		{
+			name: "DryRun",
+			ctx: &context.Context{
+				AtomicPush:  true,
+				DryRun:      true,
+				PendingPush: context.PendingPush{Tag: "v0.1.0"},
+			},
+		},
+		{
+			name: "NoPush",
+			ctx: &context.Context{
+				AtomicPush:  true,
+				NoPush:      true,
+				PendingPush: context.PendingPush{Tag: "v0.1.0"},
+			},
+		},
+		{
+			name: "NothingPending",
+			ctx: &context.Context{
+				AtomicPush: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, Task{}.Skip(tt.ctx))
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CommitEmpty(t, "feat: a new feature")
+	gittest.Tag(t, "v0.1.0")
+
+	gitc, _ := git.NewClient()
+	ctx := &context.Context{
+		GitClient:  trace.NewGitClient(gitc, nil),
+		AtomicPush: true,
+		PendingPush: context.PendingPush{
+			Branch: gittest.DefaultBranch,
+			Tag:    "v0.1.0",
+		},
+		Journal: &journal.Journal{},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"v0.1.0"}, gittest.RemoteTags(t))
+	assert.Equal(t, "feat: a new feature", gittest.RemoteLog(t)[0].Message)
+
+	entries := ctx.Journal.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, journal.Pushed, entries[0].Kind)
+	assert.Equal(t, gittest.DefaultBranch, entries[0].Ref)
+	assert.Equal(t, "v0.1.0", entries[1].Ref)
+}
+
+func TestRun_Rejected(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithRemoteLog("(main, origin/main) fix: a remote only fix"))
+	gittest.CommitEmpty(t, "feat: a new feature")
+	gittest.Tag(t, "v0.1.0")
+
+	gitc, _ := git.NewClient()
+	ctx := &context.Context{
+		GitClient:  trace.NewGitClient(gitc, nil),
+		AtomicPush: true,
+		PendingPush: context.PendingPush{
+			Branch: gittest.DefaultBranch,
+			Tag:    "v0.1.0",
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	var rejErr ErrPushRejected
+	require.ErrorAs(t, err, &rejErr)
+
+	// Neither reference should have been pushed
+	assert.Empty(t, gittest.RemoteTags(t))
+	gittest.MustExec(t, "git fetch origin")
+	assert.Equal(t, "fix: a remote only fix", gittest.RemoteLog(t)[0].Message)
+}
+
+func TestFilterPushOptions(t *testing.T) {
+	pushOpts := []config.GitPushOption{
+		{
+			Option: "option1",
+		},
+		{
+			Option:     "option2",
+			SkipBranch: true,
+		},
+		{
+			Option:  "option3",
+			SkipTag: true,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		pending  context.PendingPush
+		expected []string
+	}{
+		{
+			name:     "BranchOnly",
+			pending:  context.PendingPush{Branch: "main"},
+			expected: []string{"option1", "option3"},
+		},
+		{
+			name:     "TagOnly",
+			pending:  context.PendingPush{Tag: "v0.1.0"},
+			expected: []string{"option1", "option2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := filterPushOptions(pushOpts, tt.pending)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, filtered)
+		})
+	}
+}
+
+func TestFilterPushOptions_BranchAndTag(t *testing.T) {
+	pushOpts := []config.GitPushOption{
+		{
+			Option: "option1",
+		},
+	}
+
+	filtered, err := filterPushOptions(pushOpts, context.PendingPush{Branch: "main", Tag: "v0.1.0"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"option1"}, filtered)
+}
+
+func TestRun_TargetedPushOptions(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.CommitEmpty(t, "feat: a new feature")
+	gittest.Tag(t, "v0.1.0")
+
+	gitc, _ := git.NewClient()
+	ctx := &context.Context{
+		GitClient:  trace.NewGitClient(gitc, nil),
+		AtomicPush: true,
+		Config: config.Uplift{
+			Git: &config.Git{
+				PushOptions: []config.GitPushOption{
+					{
+						Option: "option1",
+					},
+					{
+						Option:  "ci.skip",
+						SkipTag: true,
+					},
+				},
+			},
+		},
+		PendingPush: context.PendingPush{
+			Branch: gittest.DefaultBranch,
+			Tag:    "v0.1.0",
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	var optErr ErrTargetedPushOptions
+	require.ErrorAs(t, err, &optErr)
+	assert.ErrorContains(t, err, "[ci.skip]")
+
+	// Nothing should have been pushed
+	assert.Empty(t, gittest.RemoteTags(t))
+}
@@ -46,34 +46,61 @@ func (t Task) Run(ctx *context.Context) error {
 	}
 
 	log.Debug("attempting to tag repository")
-	if ctx.Config.AnnotatedTags {
-		if _, err := ctx.GitClient.Tag(ctx.NextVersion.Raw,
+
+	// The tag is always created locally, so it is recorded within the journal
+	// before any push. An atomic push defers this until the release commit can
+	// be pushed alongside it
+	tagOpts := []git.CreateTagOption{git.WithLocalOnly()}
+
+	// A release published through a pull request is tagged at its merge commit
+	if ctx.TagCommit != "" {
+		tagOpts = append(tagOpts, git.WithCommitRef(ctx.TagCommit))
+	}
+
+	// A signed tag is always annotated
+	tagType := "lightweight"
+	if ctx.SignTags || ctx.Config.AnnotatedTags {
+		tagType = "annotated"
+		tagOpts = append(tagOpts,
 			git.WithTagConfig("user.name", ctx.CommitDetails.Author.Name, "user.email", ctx.CommitDetails.Author.Email),
-			git.WithAnnotation(ctx.CommitDetails.Message)); err != nil {
-			return err
-		}
-		log.Info("tagged repository with annotated tag")
-	} else {
-		if _, err := ctx.GitClient.Tag(ctx.NextVersion.Raw); err != nil {
-			return err
-		}
-		log.Info("tagged repository with lightweight tag")
+			git.WithAnnotation(ctx.CommitDetails.Message))
+	}
+
+	if ctx.SignTags {
+		tagType = "signed"
+		tagOpts = append(tagOpts, git.WithSigned())
 	}
 
+	if _, err := ctx.GitClient.Tag(ctx.NextVersion.Raw, tagOpts...); err != nil {
+		return err
+	}
+	ctx.Journal.Tagged(ctx.NextVersion.Raw)
+	log.Info("tagged repository with " + tagType + " tag")
+
 	if ctx.NoPush {
 		log.Warn("skipping push of tag to remote")
 		return nil
 	}
 
+	if ctx.AtomicPush {
+		log.WithField("tag", ctx.NextVersion.Raw).Info("deferring push of tag for atomic push")
+		ctx.PendingPush.Tag = ctx.NextVersion.Raw
+		return nil
+	}
+
 	log.Info("pushing tag to remote")
 	var pushOpts []string
 	if ctx.Config.Git != nil {
 		pushOpts = filterPushOptions(ctx.Config.Git.PushOptions)
 	}
 
-	_, err := ctx.GitClient.Push(git.WithRefSpecs(ctx.NextVersion.Raw),
-		git.WithPushOptions(pushOpts...))
-	return err
+	if _, err := ctx.GitClient.Push(git.WithRefSpecs(ctx.NextVersion.Raw),
+		git.WithPushOptions(pushOpts...)); err != nil {
+		return err
+	}
+	ctx.Journal.Pushed(ctx.NextVersion.Raw)
+
+	return nil
 }
 
 func printRepositoryTag(ctx *context.Context) {
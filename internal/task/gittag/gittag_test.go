@@ -7,7 +7,10 @@ import (
 
 	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/gpg"
+	"github.com/gembaadvantage/uplift/internal/journal"
 	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/ssh/sshtest"
 	git "github.com/purpleclay/gitz"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +35,6 @@ func TestRun(t *testing.T) {
 		NextVersion: semver.Version{
 			Raw: "1.1.0",
 		},
-		NoPush: true,
 	}
 
 	err := Task{}.Run(ctx)
@@ -42,6 +44,43 @@ func TestRun(t *testing.T) {
 	assert.ElementsMatch(t, []string{"1.0.0", "1.1.0"}, tags)
 }
 
+func TestRun_NoPush(t *testing.T) {
+	log := "(tag: 1.0.0) feat: an exciting new feature"
+	gittest.InitRepository(t, gittest.WithLog(log))
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "1.1.0",
+		},
+		NoPush: true,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"1.0.0", "1.1.0"}, gittest.Tags(t))
+	assert.ElementsMatch(t, []string{"1.0.0"}, gittest.RemoteTags(t))
+}
+
+func TestRun_JournalsTagBeforePush(t *testing.T) {
+	gittest.InitRepository(t)
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "v0.1.0",
+		},
+		Journal: &journal.Journal{},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	entries := ctx.Journal.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, journal.Entry{Kind: journal.Tagged, Ref: "v0.1.0"}, entries[0])
+	assert.Equal(t, journal.Entry{Kind: journal.Pushed, Ref: "v0.1.0"}, entries[1])
+}
+
 func TestRun_DryRunMode(t *testing.T) {
 	gittest.InitRepository(t)
 
@@ -191,3 +230,97 @@ func TestFilterPushOptions(t *testing.T) {
 	assert.Len(t, filtered, 2)
 	assert.Equal(t, []string{"option1", "option3"}, filtered)
 }
+
+func TestRun_AtomicPushDefersPush(t *testing.T) {
+	gittest.InitRepository(t)
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "v0.1.0",
+		},
+		AtomicPush: true,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "v0.1.0", ctx.PendingPush.Tag)
+	assert.Contains(t, gittest.Tags(t), "v0.1.0")
+	assert.Empty(t, gittest.RemoteTags(t))
+}
+
+func TestRun_TagCommit(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: a new feature"))
+	hash := gittest.LastCommit(t).Hash
+	gittest.CommitEmpty(t, "docs: update documentation")
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "v0.1.0",
+		},
+		TagCommit: hash,
+		NoPush:    true,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, hash, gittest.MustExec(t, "git rev-list -n 1 v0.1.0"))
+}
+
+func TestRun_SignedTagSSH(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: a new feature"))
+
+	sshtest.ConfigureSigning(t)
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "v0.1.0",
+		},
+		CommitDetails: git.CommitDetails{
+			Author: git.Person{
+				Name:  "uplift",
+				Email: "uplift@test.com",
+			},
+			Message: "ci(uplift): uplifted for version v0.1.0",
+		},
+		SignTags: true,
+		NoPush:   true,
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	gittest.MustExec(t, "git tag -v v0.1.0")
+	assert.Equal(t, "ci(uplift): uplifted for version v0.1.0", gittest.MustExec(t, "git tag -l v0.1.0 --format='%(subject)'"))
+}
+
+func TestRun_SignedTagGPG(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: a new feature"))
+
+	details, err := gpg.ImportKey(gpg.TestKey, gpg.TestPassphrase, gpg.TestFingerprint)
+	require.NoError(t, err)
+	t.Cleanup(func() { gpg.RemoveHome(details.Home) })
+	t.Setenv("GNUPGHOME", details.Home)
+	gittest.MustExec(t, "git config user.signingKey "+gpg.TestKeyID)
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{
+			Raw: "v0.1.0",
+		},
+		CommitDetails: git.CommitDetails{
+			Author: git.Person{
+				Name:  gpg.TestKeyUserName,
+				Email: gpg.TestKeyUserEmail,
+			},
+			Message: "ci(uplift): uplifted for version v0.1.0",
+		},
+		SignTags: true,
+		NoPush:   true,
+	}
+
+	err = Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	gittest.MustExec(t, "git tag -v v0.1.0")
+}
@@ -6,16 +6,18 @@ import (
 
 	"github.com/apex/log"
 	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/gitconfig"
 	"github.com/gembaadvantage/uplift/internal/gpg"
 )
 
 const (
+	envGnupgHome      = "GNUPGHOME"
 	envGpgKey         = "UPLIFT_GPG_KEY"
 	envGpgPassphrase  = "UPLIFT_GPG_PASSPHRASE"
 	envGpgFingerprint = "UPLIFT_GPG_FINGERPRINT"
 )
 
-// Task for tagging a repository
+// Task for importing a gpg key
 type Task struct{}
 
 // String generates a string representation of the task
@@ -44,9 +46,42 @@ func (t Task) Run(ctx *context.Context) error {
 	log.WithField("fingerprint", fingerprint).Info("importing gpg key")
 	keyDetails, err := gpg.ImportKey(key, passphrase, os.Getenv(envGpgFingerprint))
 	if err != nil {
+		log.WithError(err).Debug("failed to import gpg key")
 		return ErrKeyImport{fingerprint: fingerprint}
 	}
 
+	// Git signs using gpg, which must resolve the keyring the key was imported into
+	log.WithField("home", keyDetails.Home).Debug("using isolated gpg home directory")
+	prevHome, hasPrevHome := os.LookupEnv(envGnupgHome)
+	if err := os.Setenv(envGnupgHome, keyDetails.Home); err != nil {
+		gpg.RemoveHome(keyDetails.Home)
+		return err
+	}
+
+	if ctx.KeepSigningKeys {
+		log.WithField("home", keyDetails.Home).
+			Warn("imported gpg key will not be removed, set GNUPGHOME to this directory to continue signing with it")
+	} else {
+		// Signing must be disabled once the key is removed, otherwise any later
+		// commit will fail to resolve it. The identity of the key is also reverted
+		restoreConfig := gitconfig.Snapshot(ctx.GitClient, "user.signingKey", "commit.gpgsign",
+			"user.name", "user.email")
+
+		ctx.Cleanup = append(ctx.Cleanup, func() error {
+			log.Debug("removing imported gpg key")
+			if hasPrevHome {
+				os.Setenv(envGnupgHome, prevHome)
+			} else {
+				os.Unsetenv(envGnupgHome)
+			}
+
+			if err := restoreConfig(); err != nil {
+				return err
+			}
+			return gpg.RemoveHome(keyDetails.Home)
+		})
+	}
+
 	log.Info("setting git config to enable gpg signing")
 	return ctx.GitClient.ConfigSetL("user.signingKey", keyDetails.ID,
 		"commit.gpgsign", "true",
@@ -1,6 +1,7 @@
 package gpgimport
 
 import (
+	"os"
 	"testing"
 
 	"github.com/gembaadvantage/uplift/internal/context"
@@ -32,19 +33,61 @@ func TestSkipFalse(t *testing.T) {
 
 func TestRun(t *testing.T) {
 	gittest.InitRepository(t)
+	gpgSign := gittest.MustExec(t, "git config --local --get-all commit.gpgsign || true")
+	userName := gittest.MustExec(t, "git config --local --get-all user.name || true")
+	userEmail := gittest.MustExec(t, "git config --local --get-all user.email || true")
 
+	t.Setenv("GNUPGHOME", "")
 	t.Setenv("UPLIFT_GPG_KEY", gpg.TestKey)
 	t.Setenv("UPLIFT_GPG_PASSPHRASE", gpg.TestPassphrase)
 	t.Setenv("UPLIFT_GPG_FINGERPRINT", gpg.TestFingerprint)
 
-	err := Task{}.Run(&context.Context{})
-
+	ctx := &context.Context{}
+	err := Task{}.Run(ctx)
 	require.NoError(t, err)
-	gittest.MustExec(t, "")
+
+	home := os.Getenv("GNUPGHOME")
+	t.Cleanup(func() { gpg.RemoveHome(home) })
+
 	assert.Equal(t, gpg.TestKeyID, gittest.MustExec(t, "git config --get user.signingKey"))
 	assert.Equal(t, "true", gittest.MustExec(t, "git config --get commit.gpgsign"))
 	assert.Equal(t, gpg.TestKeyUserName, gittest.MustExec(t, "git config --get user.name"))
 	assert.Equal(t, gpg.TestKeyUserEmail, gittest.MustExec(t, "git config --get user.email"))
+
+	// Ensure commits are signed using the isolated keyring
+	gittest.CommitEmpty(t, "signed commit")
+	gittest.MustExec(t, "git verify-commit HEAD")
+
+	require.Len(t, ctx.Cleanup, 1)
+	require.NoError(t, ctx.Cleanup[0]())
+	assert.NoDirExists(t, home)
+	assert.Empty(t, os.Getenv("GNUPGHOME"))
+
+	// Signing config must no longer reference the removed key
+	assert.Empty(t, gittest.MustExec(t, "git config --local --get-all user.signingKey || true"))
+	assert.Equal(t, gpgSign, gittest.MustExec(t, "git config --local --get-all commit.gpgsign || true"))
+	assert.Equal(t, userName, gittest.MustExec(t, "git config --local --get-all user.name || true"))
+	assert.Equal(t, userEmail, gittest.MustExec(t, "git config --local --get-all user.email || true"))
+	gittest.CommitEmpty(t, "commit after cleanup")
+}
+
+func TestRun_KeepSigningKeys(t *testing.T) {
+	gittest.InitRepository(t)
+
+	t.Setenv("GNUPGHOME", "")
+	t.Setenv("UPLIFT_GPG_KEY", gpg.TestKey)
+	t.Setenv("UPLIFT_GPG_PASSPHRASE", gpg.TestPassphrase)
+	t.Setenv("UPLIFT_GPG_FINGERPRINT", gpg.TestFingerprint)
+
+	ctx := &context.Context{KeepSigningKeys: true}
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	home := os.Getenv("GNUPGHOME")
+	t.Cleanup(func() { gpg.RemoveHome(home) })
+
+	assert.Empty(t, ctx.Cleanup)
+	assert.DirExists(t, home)
 }
 
 func TestRunImportKeyFailed(t *testing.T) {
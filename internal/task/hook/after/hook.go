@@ -22,8 +22,9 @@ func (t Task) Skip(ctx *context.Context) bool {
 // Run the task
 func (t Task) Run(ctx *context.Context) error {
 	return hook.Exec(ctx.Context, ctx.Config.Hooks.After, hook.ExecOptions{
-		DryRun: ctx.DryRun,
-		Debug:  ctx.Debug,
-		Env:    ctx.Config.Env,
+		DryRun:  ctx.DryRun,
+		Debug:   ctx.Debug,
+		Env:     ctx.Config.Env,
+		Release: hook.NewRelease(ctx),
 	})
 }
@@ -15,7 +15,7 @@ func TestString(t *testing.T) {
 }
 
 func TestSkip(t *testing.T) {
-	cmd := []string{"echo 'HELLO'"}
+	cmd := []config.Hook{{Cmd: "echo 'HELLO'"}}
 
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
@@ -24,7 +24,7 @@ func TestSkip(t *testing.T) {
 				BeforeBump:      cmd,
 				BeforeTag:       cmd,
 				BeforeChangelog: cmd,
-				After:           []string{},
+				After:           []config.Hook{},
 				AfterBump:       cmd,
 				AfterTag:        cmd,
 				AfterChangelog:  cmd,
@@ -40,7 +40,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				After: []string{"touch a.out"},
+				After: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
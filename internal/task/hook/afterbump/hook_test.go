@@ -15,7 +15,7 @@ func TestString(t *testing.T) {
 }
 
 func TestSkip(t *testing.T) {
-	cmd := []string{"echo 'HELLO'"}
+	cmd := []config.Hook{{Cmd: "echo 'HELLO'"}}
 
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
@@ -25,7 +25,7 @@ func TestSkip(t *testing.T) {
 				BeforeTag:       cmd,
 				BeforeChangelog: cmd,
 				After:           cmd,
-				AfterBump:       []string{},
+				AfterBump:       []config.Hook{},
 				AfterTag:        cmd,
 				AfterChangelog:  cmd,
 			},
@@ -52,7 +52,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				AfterBump: []string{"touch a.out"},
+				AfterBump: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
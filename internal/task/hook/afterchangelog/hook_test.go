@@ -15,7 +15,7 @@ func TestString(t *testing.T) {
 }
 
 func TestSkip(t *testing.T) {
-	cmd := []string{"echo 'HELLO'"}
+	cmd := []config.Hook{{Cmd: "echo 'HELLO'"}}
 
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
@@ -27,7 +27,7 @@ func TestSkip(t *testing.T) {
 				After:           cmd,
 				AfterBump:       cmd,
 				AfterTag:        cmd,
-				AfterChangelog:  []string{},
+				AfterChangelog:  []config.Hook{},
 			},
 		},
 	}))
@@ -52,7 +52,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				AfterChangelog: []string{"touch a.out"},
+				AfterChangelog: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
@@ -18,7 +18,7 @@ func TestSkip(t *testing.T) {
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				AfterTag: []string{},
+				AfterTag: []config.Hook{},
 			},
 		},
 	}))
@@ -37,7 +37,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				AfterTag: []string{"touch a.out"},
+				AfterTag: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
@@ -15,12 +15,12 @@ func TestString(t *testing.T) {
 }
 
 func TestSkip(t *testing.T) {
-	cmd := []string{"echo 'HELLO'"}
+	cmd := []config.Hook{{Cmd: "echo 'HELLO'"}}
 
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				Before:          []string{},
+				Before:          []config.Hook{},
 				BeforeBump:      cmd,
 				BeforeTag:       cmd,
 				BeforeChangelog: cmd,
@@ -40,7 +40,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				Before: []string{"touch a.out"},
+				Before: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
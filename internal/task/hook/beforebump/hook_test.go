@@ -15,13 +15,13 @@ func TestString(t *testing.T) {
 }
 
 func TestSkip(t *testing.T) {
-	cmd := []string{"echo 'HELLO'"}
+	cmd := []config.Hook{{Cmd: "echo 'HELLO'"}}
 
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
 				Before:          cmd,
-				BeforeBump:      []string{},
+				BeforeBump:      []config.Hook{},
 				BeforeTag:       cmd,
 				BeforeChangelog: cmd,
 				After:           cmd,
@@ -52,7 +52,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				BeforeBump: []string{"touch a.out"},
+				BeforeBump: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
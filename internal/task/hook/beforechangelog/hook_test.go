@@ -15,7 +15,7 @@ func TestString(t *testing.T) {
 }
 
 func TestSkip(t *testing.T) {
-	cmd := []string{"echo 'HELLO'"}
+	cmd := []config.Hook{{Cmd: "echo 'HELLO'"}}
 
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
@@ -23,7 +23,7 @@ func TestSkip(t *testing.T) {
 				Before:          cmd,
 				BeforeBump:      cmd,
 				BeforeTag:       cmd,
-				BeforeChangelog: []string{},
+				BeforeChangelog: []config.Hook{},
 				After:           cmd,
 				AfterBump:       cmd,
 				AfterTag:        cmd,
@@ -52,7 +52,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				BeforeChangelog: []string{"touch a.out"},
+				BeforeChangelog: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
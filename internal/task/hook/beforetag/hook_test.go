@@ -15,14 +15,14 @@ func TestString(t *testing.T) {
 }
 
 func TestSkip(t *testing.T) {
-	cmd := []string{"echo 'HELLO'"}
+	cmd := []config.Hook{{Cmd: "echo 'HELLO'"}}
 
 	assert.True(t, Task{}.Skip(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
 				Before:          cmd,
 				BeforeBump:      cmd,
-				BeforeTag:       []string{},
+				BeforeTag:       []config.Hook{},
 				BeforeChangelog: cmd,
 				After:           cmd,
 				AfterBump:       cmd,
@@ -46,7 +46,7 @@ func TestRun(t *testing.T) {
 	err := Task{}.Run(&context.Context{
 		Config: config.Uplift{
 			Hooks: &config.Hooks{
-				BeforeTag: []string{"touch a.out"},
+				BeforeTag: []config.Hook{{Cmd: "touch a.out"}},
 			},
 		},
 	})
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/trace"
 	"github.com/joho/godotenv"
 	"mvdan.cc/sh/v3/expand"
 	"mvdan.cc/sh/v3/interp"
@@ -18,16 +22,41 @@ import (
 
 var cleanEnv = regexp.MustCompile(`\s*=\s*`)
 
+const (
+	// OutputShow writes any output from a hook to stderr
+	OutputShow = "show"
+
+	// OutputHide discards any output from a hook
+	OutputHide = "hide"
+
+	// OutputFile appends any output from a hook to a file
+	OutputFile = "file"
+)
+
 // ExecOptions provides a way of customising the execution of commands
 type ExecOptions struct {
-	Debug  bool
-	DryRun bool
-	Env    []string
+	Debug   bool
+	DryRun  bool
+	Env     []string
+	Release Release
+
+	// Set when running within a parallel group, ensuring the output of each hook
+	// is prefixed and never interleaved with the output of another hook
+	prefix string
+	mu     *sync.Mutex
 }
 
-// Exec will execute a series of shell commands or scripts
-func Exec(_ context.Context, cmds []string, opts ExecOptions) error {
-	env := os.Environ()
+// Exec will execute a series of shell commands or scripts. Each hook is rendered
+// as a Go template and has access to details about the release through UPLIFT_*
+// environment variables. Execution will be aborted upon the first hook to fail,
+// unless it is configured to continue. Hooks within a parallel group are executed
+// concurrently, with all errors aggregated once the group completes
+func Exec(ctx context.Context, hooks []config.Hook, opts ExecOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	env := append(os.Environ(), opts.Release.Env()...)
 	if len(opts.Env) > 0 {
 		renv, err := resolveEnv(opts.Env)
 		if err != nil {
@@ -37,40 +66,200 @@ func Exec(_ context.Context, cmds []string, opts ExecOptions) error {
 		env = append(env, renv...)
 	}
 
-	for _, c := range cmds {
-		log.WithField("hook", c).Info("running")
-		if opts.DryRun {
-			continue
-		}
+	return execHooks(ctx, hooks, env, opts)
+}
 
-		p, err := syntax.NewParser().Parse(strings.NewReader(c), "")
-		if err != nil {
+func execHooks(ctx context.Context, hooks []config.Hook, env []string, opts ExecOptions) error {
+	for _, h := range hooks {
+		if err := runHook(ctx, h, env, opts); err != nil {
 			return err
 		}
+	}
 
-		// Discard all output from commands and scripts unless in debug mode
-		out := io.Discard
-		if opts.Debug {
-			// Stderr is used by apex for logging, stdout is reserved for capturing output
-			out = os.Stderr
-		}
+	return nil
+}
+
+func runHook(ctx context.Context, h config.Hook, env []string, opts ExecOptions) error {
+	ok, err := evaluate(h.If, opts.Release)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		log.WithField("if", h.If).Debug(fmt.Sprintf("(skipped) %s", h.String()))
+		return nil
+	}
+
+	if len(h.Hooks) == 0 {
+		h = render(h, opts.Release)
+	}
+
+	fields := log.Fields{"hook": h.String()}
+	if opts.prefix != "" {
+		fields["id"] = strings.TrimSpace(opts.prefix)
+	}
+	log.WithFields(fields).Info("running")
+
+	ctx, span := trace.Start(ctx, h.String())
+	defer func() {
+		span.End(err)
+	}()
+
+	switch {
+	case len(h.Hooks) > 0:
+		err = execGroup(ctx, h, env, opts)
+	case opts.DryRun:
+		return nil
+	default:
+		err = execHook(ctx, h, env, opts)
+	}
+
+	if err != nil && h.ContinueOnError {
+		log.WithError(err).WithField("hook", h.String()).Warn("hook failed, continuing")
+		return nil
+	}
+	return err
+}
+
+func execGroup(ctx context.Context, g config.Hook, env []string, opts ExecOptions) error {
+	if !g.Parallel {
+		return execHooks(ctx, g.Hooks, env, opts)
+	}
+
+	limit := g.Concurrency
+	if limit <= 0 || limit > len(g.Hooks) {
+		limit = len(g.Hooks)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sem  = make(chan struct{}, limit)
+		errs = make([]error, len(g.Hooks))
+	)
 
-		r, err := interp.New(
-			interp.Params("-e"),
-			interp.StdIO(os.Stdin, out, os.Stderr),
-			interp.OpenHandler(openHandler),
-			interp.Env(expand.ListEnviron(env...)),
-		)
+	for i, h := range g.Hooks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			hopts := opts
+			hopts.prefix = fmt.Sprintf("[%d] ", i+1)
+			hopts.mu = &mu
+
+			if err := runHook(ctx, h, env, hopts); err != nil {
+				errs[i] = fmt.Errorf("%s%s: %w", hopts.prefix, h.String(), err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func execHook(ctx context.Context, h config.Hook, env []string, opts ExecOptions) error {
+	if len(h.Env) > 0 {
+		henv, err := resolveEnv(h.Env)
 		if err != nil {
 			return err
 		}
 
-		if err := r.Run(context.Background(), p); err != nil {
-			return err
+		// Copy to prevent hook specific variables leaking into other hooks
+		env = append(append([]string{}, env...), henv...)
+	}
+
+	stdout, stderr, closer, err := hookOutput(h, opts)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	if opts.mu != nil {
+		pout := &prefixWriter{w: stdout, prefix: opts.prefix}
+		perr := &prefixWriter{w: stderr, prefix: opts.prefix}
+		defer func() {
+			opts.mu.Lock()
+			defer opts.mu.Unlock()
+			pout.Flush()
+			perr.Flush()
+		}()
+		stdout, stderr = pout, perr
+	}
+
+	if h.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.Timeout)
+		defer cancel()
+	}
+
+	if len(h.Argv) > 0 {
+		err = execArgv(ctx, h, env, stdout, stderr)
+	} else {
+		err = execShell(ctx, h, env, stdout, stderr)
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("hook timed out after %s: %w", h.Timeout, ctx.Err())
+	}
+	return err
+}
+
+func execShell(ctx context.Context, h config.Hook, env []string, stdout, stderr io.Writer) error {
+	p, err := syntax.NewParser().Parse(strings.NewReader(h.Cmd), "")
+	if err != nil {
+		return err
+	}
+
+	r, err := interp.New(
+		interp.Params("-e"),
+		interp.StdIO(os.Stdin, stdout, stderr),
+		interp.OpenHandler(openHandler),
+		interp.Env(expand.ListEnviron(env...)),
+		interp.Dir(h.Dir),
+	)
+	if err != nil {
+		return err
+	}
+
+	return r.Run(ctx, p)
+}
+
+func execArgv(ctx context.Context, h config.Hook, env []string, stdout, stderr io.Writer) error {
+	cmd := exec.CommandContext(ctx, h.Argv[0], h.Argv[1:]...)
+	cmd.Dir = h.Dir
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	return cmd.Run()
+}
+
+// hookOutput resolves where the stdout and stderr of a hook should be written.
+// By default, stdout is discarded unless in debug mode
+func hookOutput(h config.Hook, opts ExecOptions) (io.Writer, io.Writer, func(), error) {
+	switch h.Output {
+	case OutputShow:
+		// Stderr is used by apex for logging, stdout is reserved for capturing output
+		return os.Stderr, os.Stderr, func() {}, nil
+	case OutputHide:
+		return io.Discard, io.Discard, func() {}, nil
+	case OutputFile:
+		f, err := os.OpenFile(h.OutputFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, nil, err
 		}
+		return f, f, func() { f.Close() }, nil
 	}
 
-	return nil
+	// Discard all output from commands and scripts unless in debug mode
+	out := io.Discard
+	if opts.Debug {
+		out = os.Stderr
+	}
+	return out, os.Stderr, func() {}, nil
 }
 
 func openHandler(ctx context.Context, path string, flag int, perm os.FileMode) (io.ReadWriteCloser, error) {
@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,9 +14,9 @@ import (
 func TestExec_ShellCommands(t *testing.T) {
 	gittest.InitRepository(t)
 
-	cmds := []string{
-		"echo -n 'JohnDoe' > out.txt",
-		"sed -i '' 's/Doe/Smith/g' out.txt",
+	cmds := []config.Hook{
+		{Cmd: "echo -n 'JohnDoe' > out.txt"},
+		{Cmd: "sed -i '' 's/Doe/Smith/g' out.txt"},
 	}
 
 	err := Exec(context.Background(), cmds, ExecOptions{})
@@ -37,7 +38,7 @@ func TestExec_ShellScripts(t *testing.T) {
 	echo -n $CURRENT > out.txt`
 	os.WriteFile("switch-branch.sh", []byte(sh), 0o755)
 
-	err := Exec(context.Background(), []string{"BRANCH=testing ./switch-branch.sh"}, ExecOptions{})
+	err := Exec(context.Background(), []config.Hook{{Cmd: "BRANCH=testing ./switch-branch.sh"}}, ExecOptions{})
 	require.NoError(t, err)
 
 	data, err := os.ReadFile("out.txt")
@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,9 +15,9 @@ import (
 func TestExec_ShellCommands(t *testing.T) {
 	gittest.InitRepository(t)
 
-	cmds := []string{
-		"echo -n 'JohnDoe' > out.txt",
-		"sed -i 's/Doe/Smith/g' out.txt",
+	cmds := []config.Hook{
+		{Cmd: "echo -n 'JohnDoe' > out.txt"},
+		{Cmd: "sed -i 's/Doe/Smith/g' out.txt"},
 	}
 
 	err := Exec(context.Background(), cmds, ExecOptions{})
@@ -37,7 +39,7 @@ func TestExec_ShellScripts(t *testing.T) {
 	echo -n $LATEST_TAG > out.txt`
 	os.WriteFile("latest-tag.sh", []byte(sh), 0o755)
 
-	err := Exec(context.Background(), []string{"./latest-tag.sh"}, ExecOptions{})
+	err := Exec(context.Background(), []config.Hook{{Cmd: "./latest-tag.sh"}}, ExecOptions{})
 	require.NoError(t, err)
 
 	data, err := os.ReadFile("out.txt")
@@ -45,3 +47,93 @@ func TestExec_ShellScripts(t *testing.T) {
 
 	assert.Equal(t, "1.0.0", string(data))
 }
+
+func TestExec_Timeout(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "sleep 5 && touch out.txt", Timeout: 100 * time.Millisecond},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NoFileExists(t, "out.txt")
+}
+
+func TestExec_ArgvTimeout(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Argv: []string{"sleep", "5"}, Timeout: 100 * time.Millisecond},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestExec_ParallelGroup(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{
+			Parallel: true,
+			Hooks: []config.Hook{
+				{Cmd: "sleep 0.5 && touch a.out"},
+				{Cmd: "sleep 0.5 && touch b.out"},
+				{Cmd: "sleep 0.5 && touch c.out"},
+			},
+		},
+	}
+
+	start := time.Now()
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	assert.Less(t, time.Since(start), 1200*time.Millisecond)
+	assert.FileExists(t, "a.out")
+	assert.FileExists(t, "b.out")
+	assert.FileExists(t, "c.out")
+}
+
+func TestExec_ParallelGroupConcurrency(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{
+			Parallel:    true,
+			Concurrency: 1,
+			Hooks: []config.Hook{
+				{Cmd: "sleep 0.3"},
+				{Cmd: "sleep 0.3"},
+				{Cmd: "sleep 0.3"},
+			},
+		},
+	}
+
+	start := time.Now()
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestExec_ParallelGroupOutput(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{
+			Parallel: true,
+			Hooks: []config.Hook{
+				{Cmd: "echo one && sleep 0.5 && echo two", Output: OutputFile, OutputFile: "hooks.log"},
+				{Cmd: "sleep 0.1 && echo three", Output: OutputFile, OutputFile: "hooks.log"},
+			},
+		},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("hooks.log")
+	require.NoError(t, err)
+	assert.Equal(t, "[2] three\n[1] one\n[1] two\n", string(data))
+}
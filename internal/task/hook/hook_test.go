@@ -1,10 +1,12 @@
 package hook
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"testing"
 
+	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,7 +15,7 @@ import (
 func TestExec_DryRun(t *testing.T) {
 	gittest.InitRepository(t)
 
-	err := Exec(context.Background(), []string{"touch out.txt"}, ExecOptions{DryRun: true})
+	err := Exec(context.Background(), []config.Hook{{Cmd: "touch out.txt"}}, ExecOptions{DryRun: true})
 	require.NoError(t, err)
 
 	assert.NoFileExists(t, "out.txt")
@@ -24,8 +26,8 @@ func TestExec_InjectEnvVars(t *testing.T) {
 
 	env := []string{"VARIABLE=VALUE", "ANOTHER_VARIABLE=ANOTHER VALUE"}
 
-	cmds := []string{
-		"echo -n VALUE1=$VARIABLE VALUE2=$ANOTHER_VARIABLE > out.txt",
+	cmds := []config.Hook{
+		{Cmd: "echo -n VALUE1=$VARIABLE VALUE2=$ANOTHER_VARIABLE > out.txt"},
 	}
 
 	err := Exec(context.Background(), cmds, ExecOptions{Env: env})
@@ -42,8 +44,8 @@ func TestExec_MergesEnvVars(t *testing.T) {
 
 	env := []string{"TESTING=123"}
 
-	cmds := []string{
-		"printenv > out.txt",
+	cmds := []config.Hook{
+		{Cmd: "printenv > out.txt"},
 	}
 
 	err := Exec(context.Background(), cmds, ExecOptions{Env: env})
@@ -63,8 +65,8 @@ func TestExec_VarsWithWhitespace(t *testing.T) {
 
 	env := []string{"ONE = 1", "TWO= 2", "THREE    =    3"}
 
-	cmds := []string{
-		"echo -n $ONE $TWO $THREE > out.txt",
+	cmds := []config.Hook{
+		{Cmd: "echo -n $ONE $TWO $THREE > out.txt"},
 	}
 
 	err := Exec(context.Background(), cmds, ExecOptions{Env: env})
@@ -87,8 +89,8 @@ TWO   =   2`
 	dotenv2 := "THREE=    3"
 	os.WriteFile("custom/another.env", []byte(dotenv2), 0o600)
 
-	cmds := []string{
-		"echo -n $ONE $TWO $THREE > out.txt",
+	cmds := []config.Hook{
+		{Cmd: "echo -n $ONE $TWO $THREE > out.txt"},
 	}
 
 	err := Exec(context.Background(), cmds, ExecOptions{Env: []string{".env", "custom/another.env"}})
@@ -104,13 +106,157 @@ func TestExec_FailsOnInvalidDotEnvFile(t *testing.T) {
 	gittest.InitRepository(t)
 	gittest.TempFile(t, ".env", "INVALID")
 
-	err := Exec(context.Background(), []string{}, ExecOptions{Env: []string{".env"}})
+	err := Exec(context.Background(), nil, ExecOptions{Env: []string{".env"}})
 	require.Error(t, err)
 }
 
 func TestExec_FailsIfDotEnvFileNotFound(t *testing.T) {
 	gittest.InitRepository(t)
 
-	err := Exec(context.Background(), []string{}, ExecOptions{Env: []string{"does-not-exist.env"}})
+	err := Exec(context.Background(), nil, ExecOptions{Env: []string{"does-not-exist.env"}})
 	require.Error(t, err)
 }
+
+func TestExec_Dir(t *testing.T) {
+	gittest.InitRepository(t)
+	require.NoError(t, os.Mkdir("nested", 0o755))
+
+	err := Exec(context.Background(), []config.Hook{{Cmd: "echo -n nested > out.txt", Dir: "nested"}}, ExecOptions{})
+	require.NoError(t, err)
+
+	assert.FileExists(t, "nested/out.txt")
+}
+
+func TestExec_HookEnvVars(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "echo -n $GLOBAL $LOCAL > out1.txt", Env: []string{"LOCAL=local"}},
+		{Cmd: "echo -n $GLOBAL $LOCAL > out2.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{Env: []string{"GLOBAL=global"}})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("out1.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "global local", string(data))
+
+	data, err = os.ReadFile("out2.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "global", string(data))
+}
+
+func TestExec_FailFast(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "exit 1"},
+		{Cmd: "touch out.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.Error(t, err)
+	assert.NoFileExists(t, "out.txt")
+}
+
+func TestExec_ContinueOnError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "exit 1", ContinueOnError: true},
+		{Cmd: "touch out.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+	assert.FileExists(t, "out.txt")
+}
+
+func TestExec_Argv(t *testing.T) {
+	gittest.InitRepository(t)
+
+	// Without a shell, variables are passed to git as literal arguments
+	hooks := []config.Hook{
+		{Argv: []string{"git", "tag", "$VERSION"}, Env: []string{"VERSION=1.0.0"}},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "$VERSION", gittest.MustExec(t, "git tag -l"))
+}
+
+func TestExec_OutputFile(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "echo first", Output: OutputFile, OutputFile: "hooks.log"},
+		{Cmd: "echo second", Output: OutputFile, OutputFile: "hooks.log"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("hooks.log")
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\n", string(data))
+}
+
+func TestExec_ParallelGroupAggregatesErrors(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{
+			Parallel: true,
+			Hooks: []config.Hook{
+				{Cmd: "exit 1"},
+				{Cmd: "touch out.txt"},
+				{Cmd: "exit 2"},
+				{Cmd: "exit 3", ContinueOnError: true},
+			},
+		},
+		{Cmd: "touch after.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.Error(t, err)
+
+	assert.ErrorContains(t, err, "[1] exit 1: exit status 1")
+	assert.ErrorContains(t, err, "[3] exit 2: exit status 2")
+	assert.NotContains(t, err.Error(), "exit 3")
+	assert.FileExists(t, "out.txt")
+	assert.NoFileExists(t, "after.txt")
+}
+
+func TestExec_SequentialGroup(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{
+			Hooks: []config.Hook{
+				{Cmd: "echo -n one > out.txt"},
+				{Cmd: "echo -n ' two' >> out.txt"},
+			},
+		},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("out.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "one two", string(data))
+}
+
+func TestPrefixWriter(t *testing.T) {
+	var buf bytes.Buffer
+	pw := &prefixWriter{w: &buf, prefix: "[1] "}
+
+	pw.Write([]byte("first\nsec"))
+	pw.Write([]byte("ond"))
+	assert.Empty(t, buf.String())
+
+	require.NoError(t, pw.Flush())
+	assert.Equal(t, "[1] first\n[1] second\n", buf.String())
+}
@@ -0,0 +1,36 @@
+package hook
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// prefixWriter buffers all output until flushed, at which point each line is
+// written with a prefix. Buffering ensures output from hooks running in parallel
+// is never interleaved
+type prefixWriter struct {
+	w      io.Writer
+	prefix string
+	buf    bytes.Buffer
+}
+
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	return p.buf.Write(b)
+}
+
+// Flush all buffered output, prefixing each line
+func (p *prefixWriter) Flush() error {
+	if p.buf.Len() == 0 {
+		return nil
+	}
+
+	var out strings.Builder
+	for _, line := range strings.SplitAfter(strings.TrimSuffix(p.buf.String(), "\n"), "\n") {
+		out.WriteString(p.prefix + strings.TrimSuffix(line, "\n") + "\n")
+	}
+	p.buf.Reset()
+
+	_, err := io.WriteString(p.w, out.String())
+	return err
+}
@@ -0,0 +1,165 @@
+package hook
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/task/changelog"
+)
+
+// Release contains details about the current release. These details are exposed
+// to every hook as UPLIFT_* environment variables, and as fields when rendering a
+// hook as a Go template
+type Release struct {
+	CurrentVersion string
+	NextVersion    string
+	Increment      string
+	Prerelease     string
+	Metadata       string
+	ChangelogPath  string
+	DryRun         bool
+	NoPush         bool
+	SCMProvider    string
+	Branch         string
+
+	// Only set when executing onError and always hooks, after a task has failed
+	FailedTask string
+	Error      string
+}
+
+// NewRelease captures details about the current release from the [context.Context]
+func NewRelease(ctx *context.Context) Release {
+	return Release{
+		CurrentVersion: ctx.CurrentVersion.Raw,
+		NextVersion:    ctx.NextVersion.Raw,
+		Increment:      string(ctx.Increment),
+		Prerelease:     ctx.NextVersion.Prerelease,
+		Metadata:       ctx.NextVersion.Metadata,
+		ChangelogPath:  changelog.MarkdownFile,
+		DryRun:         ctx.DryRun,
+		NoPush:         ctx.NoPush,
+		SCMProvider:    string(ctx.SCM.Provider),
+		Branch:         branch(ctx),
+	}
+}
+
+func branch(ctx *context.Context) string {
+	if ctx.GitClient == nil {
+		return ""
+	}
+
+	// A detached HEAD will not report a branch
+	b, err := ctx.GitClient.Exec("git branch --show-current")
+	if err != nil {
+		return ""
+	}
+	return b
+}
+
+// Env returns the release details as a list of UPLIFT_* environment variables
+func (r Release) Env() []string {
+	return []string{
+		"UPLIFT_CURRENT_VERSION=" + r.CurrentVersion,
+		"UPLIFT_NEXT_VERSION=" + r.NextVersion,
+		"UPLIFT_INCREMENT=" + r.Increment,
+		"UPLIFT_PRERELEASE=" + r.Prerelease,
+		"UPLIFT_METADATA=" + r.Metadata,
+		"UPLIFT_CHANGELOG_PATH=" + r.ChangelogPath,
+		"UPLIFT_DRY_RUN=" + strconv.FormatBool(r.DryRun),
+		"UPLIFT_NO_PUSH=" + strconv.FormatBool(r.NoPush),
+		"UPLIFT_SCM_PROVIDER=" + r.SCMProvider,
+		"UPLIFT_BRANCH=" + r.Branch,
+		"UPLIFT_FAILED_TASK=" + r.FailedTask,
+		"UPLIFT_ERROR=" + r.Error,
+	}
+}
+
+var conditionFuncs = template.FuncMap{
+	"contains":  strings.Contains,
+	"hasPrefix": strings.HasPrefix,
+	"hasSuffix": strings.HasSuffix,
+}
+
+// render each field of the hook as a Go template against the release details
+func render(h config.Hook, r Release) config.Hook {
+	h.Cmd = renderString(h.Cmd, r)
+	h.Dir = renderString(h.Dir, r)
+	h.OutputFile = renderString(h.OutputFile, r)
+
+	if len(h.Argv) > 0 {
+		argv := make([]string, 0, len(h.Argv))
+		for _, arg := range h.Argv {
+			argv = append(argv, renderString(arg, r))
+		}
+		h.Argv = argv
+	}
+
+	if len(h.Env) > 0 {
+		env := make([]string, 0, len(h.Env))
+		for _, e := range h.Env {
+			env = append(env, renderString(e, r))
+		}
+		h.Env = env
+	}
+
+	return h
+}
+
+func renderString(s string, r Release) string {
+	if !strings.Contains(s, "{{") {
+		return s
+	}
+
+	// Hooks may legitimately contain Go templates intended for other tools, such as
+	// docker inspect --format '{{.Id}}'. These are left untouched
+	tpl, err := template.New("hook").Option("missingkey=error").Parse(s)
+	if err != nil {
+		log.WithError(err).WithField("hook", s).Debug("hook is not a valid template, running as is")
+		return s
+	}
+
+	// A template that parses but cannot be rendered is most likely a typo within
+	// a release detail, such as {{.NextVersio}}, and must be visible
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, r); err != nil {
+		log.WithError(err).WithField("hook", s).Warn("hook template could not be rendered, running as is")
+		return s
+	}
+	return buf.String()
+}
+
+// evaluate the condition of a hook against the release details. A condition is
+// a Go template expression that must evaluate to a boolean, and can optionally
+// be wrapped within {{ }}
+func evaluate(cond string, r Release) (bool, error) {
+	cond = strings.TrimSpace(cond)
+	if cond == "" {
+		return true, nil
+	}
+
+	if !strings.HasPrefix(cond, "{{") {
+		cond = "{{ " + cond + " }}"
+	}
+
+	tpl, err := template.New("if").Funcs(conditionFuncs).Option("missingkey=error").Parse(cond)
+	if err != nil {
+		return false, fmt.Errorf("invalid hook condition: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, r); err != nil {
+		return false, fmt.Errorf("invalid hook condition: %w", err)
+	}
+
+	ok, err := strconv.ParseBool(strings.TrimSpace(buf.String()))
+	if err != nil {
+		return false, fmt.Errorf("hook condition %q must evaluate to either true or false, got %q", cond, buf.String())
+	}
+	return ok, nil
+}
@@ -0,0 +1,219 @@
+package hook
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/cli"
+	"github.com/apex/log/handlers/memory"
+	"github.com/gembaadvantage/uplift/internal/config"
+	uctx "github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRelease(t *testing.T) {
+	rel := NewRelease(&uctx.Context{
+		CurrentVersion: semver.Version{Raw: "v1.0.0"},
+		NextVersion:    semver.Version{Raw: "v1.1.0-beta.1+build", Prerelease: "beta.1", Metadata: "build"},
+		Increment:      semver.MinorIncrement,
+		DryRun:         true,
+		SCM:            uctx.SCM{Provider: uctx.GitHub},
+	})
+
+	assert.Equal(t, Release{
+		CurrentVersion: "v1.0.0",
+		NextVersion:    "v1.1.0-beta.1+build",
+		Increment:      "Minor",
+		Prerelease:     "beta.1",
+		Metadata:       "build",
+		ChangelogPath:  "CHANGELOG.md",
+		DryRun:         true,
+		SCMProvider:    "GitHub",
+	}, rel)
+}
+
+func TestExec_ReleaseEnvVars(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "echo -n $UPLIFT_CURRENT_VERSION $UPLIFT_NEXT_VERSION $UPLIFT_INCREMENT $UPLIFT_DRY_RUN $UPLIFT_SCM_PROVIDER > out.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{
+		Release: Release{
+			CurrentVersion: "v1.0.0",
+			NextVersion:    "v1.1.0",
+			Increment:      "Minor",
+			SCMProvider:    "GitHub",
+		},
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("out.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0 v1.1.0 Minor false GitHub", string(data))
+}
+
+func TestExec_RendersTemplate(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "echo -n {{.NextVersion}} > {{.Increment}}.txt"},
+		{Argv: []string{"git", "tag", "{{.NextVersion}}"}},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{
+		Release: Release{
+			NextVersion: "v1.1.0",
+			Increment:   "Minor",
+		},
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("Minor.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.1.0", string(data))
+	assert.Equal(t, "v1.1.0", gittest.MustExec(t, "git tag -l"))
+}
+
+func TestExec_IgnoresUnknownTemplate(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{Cmd: "echo -n '{{.Id}}' > out.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("out.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "{{.Id}}", string(data))
+}
+
+func TestExec_WarnsUnrenderedTemplate(t *testing.T) {
+	gittest.InitRepository(t)
+
+	handler := memory.New()
+	log.SetHandler(handler)
+	t.Cleanup(func() { log.SetHandler(cli.Default) })
+
+	hooks := []config.Hook{
+		{Cmd: "echo -n '{{.NextVersio}}' > out.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{
+		Release: Release{NextVersion: "v1.1.0"},
+	})
+	require.NoError(t, err)
+
+	var warning *log.Entry
+	for _, e := range handler.Entries {
+		if e.Level == log.WarnLevel {
+			warning = e
+		}
+	}
+	require.NotNil(t, warning)
+	assert.Equal(t, "hook template could not be rendered, running as is", warning.Message)
+	assert.Contains(t, warning.Fields["error"], "NextVersio")
+}
+
+func TestExec_If(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{If: `eq .Increment "Major"`, Cmd: "touch major.txt"},
+		{If: `eq .Prerelease ""`, Cmd: "touch stable.txt"},
+		{If: `{{ and (eq .Branch "main") (not .DryRun) }}`, Cmd: "touch main.txt"},
+		{If: `hasPrefix .NextVersion "v2"`, Cmd: "touch v2.txt"},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{
+		Release: Release{
+			NextVersion: "v1.1.0-beta.1",
+			Increment:   "Minor",
+			Prerelease:  "beta.1",
+			Branch:      "main",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, "major.txt")
+	assert.NoFileExists(t, "stable.txt")
+	assert.FileExists(t, "main.txt")
+	assert.NoFileExists(t, "v2.txt")
+}
+
+func TestExec_IfSkipsGroup(t *testing.T) {
+	gittest.InitRepository(t)
+
+	hooks := []config.Hook{
+		{
+			If:       ".DryRun",
+			Parallel: true,
+			Hooks: []config.Hook{
+				{Cmd: "touch a.txt"},
+				{Cmd: "touch b.txt"},
+			},
+		},
+	}
+
+	err := Exec(context.Background(), hooks, ExecOptions{})
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, "a.txt")
+	assert.NoFileExists(t, "b.txt")
+}
+
+func TestExec_IfInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		cond string
+		err  string
+	}{
+		{
+			name: "UnknownField",
+			cond: ".Unknown",
+			err:  "invalid hook condition",
+		},
+		{
+			name: "ParseError",
+			cond: "eq .Increment",
+			err:  "invalid hook condition",
+		},
+		{
+			name: "NotBoolean",
+			cond: ".NextVersion",
+			err:  "must evaluate to either true or false",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gittest.InitRepository(t)
+
+			err := Exec(context.Background(), []config.Hook{{If: tt.cond, Cmd: "touch out.txt"}}, ExecOptions{
+				Release: Release{NextVersion: "v1.0.0", Increment: "Minor"},
+			})
+			require.ErrorContains(t, err, tt.err)
+			assert.NoFileExists(t, "out.txt")
+		})
+	}
+}
+
+func TestNewRelease_Branch(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git checkout -b release")
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+
+	rel := NewRelease(&uctx.Context{GitClient: trace.NewGitClient(gitc, nil)})
+	assert.Equal(t, "release", rel.Branch)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gembaadvantage/uplift/internal/context"
 	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/trace"
 )
 
 // Task that determines the next semantic version of a repository
@@ -51,6 +52,7 @@ func (t Task) Run(ctx *context.Context) error {
 
 	// Identify any commit that will trigger the largest semantic version bump
 	inc := semver.ParseLogWithOptions(glog.Commits, semver.ParseOptions{TrimHeader: ctx.Changelog.TrimHeader})
+	ctx.Increment = inc
 	if inc == semver.NoIncrement {
 		ctx.NoVersionChanged = true
 
@@ -114,7 +116,7 @@ func (t Task) Run(ctx *context.Context) error {
 	return nil
 }
 
-func latestTag(gitc *git.Client, suffix string) (string, error) {
+func latestTag(gitc *trace.GitClient, suffix string) (string, error) {
 	tags, err := gitc.Tags(git.WithShellGlob("*.*.*"),
 		git.WithSortBy(git.CreatorDateDesc, git.VersionDesc))
 	if err != nil {
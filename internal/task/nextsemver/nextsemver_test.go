@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/semver"
 	"github.com/purpleclay/gitz/gittest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,24 +27,28 @@ func TestRun(t *testing.T) {
 		prerelease string
 		metadata   string
 		expected   string
+		increment  semver.Increment
 	}{
 		{
-			name:     "PatchIncrement",
-			commit:   "fix: a new fix",
-			curVer:   "0.1.0",
-			expected: "0.1.1",
+			name:      "PatchIncrement",
+			commit:    "fix: a new fix",
+			curVer:    "0.1.0",
+			expected:  "0.1.1",
+			increment: semver.PatchIncrement,
 		},
 		{
-			name:     "MinorIncrement",
-			commit:   "feat: a new feature",
-			curVer:   "v0.3.0",
-			expected: "v0.4.0",
+			name:      "MinorIncrement",
+			commit:    "feat: a new feature",
+			curVer:    "v0.3.0",
+			expected:  "v0.4.0",
+			increment: semver.MinorIncrement,
 		},
 		{
-			name:     "MajorIncrement",
-			commit:   "feat!: a breaking change",
-			curVer:   "1.0.0",
-			expected: "2.0.0",
+			name:      "MajorIncrement",
+			commit:    "feat!: a breaking change",
+			curVer:    "1.0.0",
+			expected:  "2.0.0",
+			increment: semver.MajorIncrement,
 		},
 		{
 			name:       "MinorIncrementWithPrerelease",
@@ -52,13 +57,15 @@ func TestRun(t *testing.T) {
 			prerelease: "beta.1",
 			metadata:   "12345",
 			expected:   "v0.2.0-beta.1+12345",
+			increment:  semver.MinorIncrement,
 		},
 		{
 			name: "BreakingChangeFooter",
 			commit: `refactor: changed the cli
 BREAKING CHANGE: no backwards compatibility support`,
-			curVer:   "v0.9.2",
-			expected: "v1.0.0",
+			curVer:    "v0.9.2",
+			expected:  "v1.0.0",
+			increment: semver.MajorIncrement,
 		},
 	}
 	for _, tt := range tests {
@@ -75,6 +82,7 @@ BREAKING CHANGE: no backwards compatibility support`,
 
 			require.NoError(t, err)
 			require.Equal(t, tt.expected, ctx.NextVersion.Raw)
+			require.Equal(t, tt.increment, ctx.Increment)
 		})
 	}
 }
@@ -0,0 +1,62 @@
+package openpr
+
+import (
+	"fmt"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/pullrequest"
+)
+
+// Task for opening a pull request from the release branch, using the
+// changelog entry of the release as its description
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "opening pull request"
+}
+
+// Skip running the task if a release branch has not been pushed
+func (t Task) Skip(ctx *context.Context) bool {
+	return ctx.PullRequest == nil || ctx.PullRequest.Branch == "" ||
+		ctx.DryRun || ctx.NoPush || ctx.NoVersionChanged
+}
+
+// Run the task
+func (t Task) Run(ctx *context.Context) error {
+	ahead, err := ctx.GitClient.Exec(fmt.Sprintf("git rev-list --count %s..%s",
+		ctx.PullRequest.Base, ctx.PullRequest.Branch))
+	if err != nil {
+		return err
+	}
+
+	if ahead == "0" {
+		log.WithField("branch", ctx.PullRequest.Branch).Warn("no changes committed, skipping pull request")
+		return nil
+	}
+
+	client, err := pullrequest.NewClient(ctx.SCM)
+	if err != nil {
+		return err
+	}
+
+	pr, err := client.Open(pullrequest.Request{
+		Title: ctx.CommitDetails.Message,
+		Body:  ctx.ReleaseNotes,
+		Head:  ctx.PullRequest.Branch,
+		Base:  ctx.PullRequest.Base,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"number": pr.Number,
+		"url":    pr.URL,
+	}).Info("opened pull request")
+
+	ctx.PullRequest.Number = pr.Number
+	ctx.PullRequest.URL = pr.URL
+	return nil
+}
@@ -0,0 +1,109 @@
+package openpr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/pullrequest"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "opening pull request", Task{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  *context.Context
+	}{
+		{
+			name: "NotPullRequestMode",
+			ctx:  &context.Context{},
+		},
+		{
+			name: "NoReleaseBranch",
+			ctx: &context.Context{
+				PullRequest: &context.PullRequest{},
+			},
+		},
+		{
+			name: "NoPush",
+			ctx: &context.Context{
+				PullRequest: &context.PullRequest{Branch: "uplift/release-v0.1.0"},
+				NoPush:      true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, Task{}.Skip(tt.ctx))
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git checkout -q -b uplift/release-v0.1.0")
+	gittest.CommitEmpty(t, "ci(uplift): uplifted for version v0.1.0")
+
+	var body map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/pulls", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number": 1, "html_url": "https://github.com/owner/repo/pull/1"}`))
+	}))
+	defer srv.Close()
+	t.Setenv(pullrequest.GitHubTokenEnv, "token")
+
+	ctx := &context.Context{
+		CommitDetails: git.CommitDetails{Message: "ci(uplift): uplifted for version v0.1.0"},
+		ReleaseNotes:  "## v0.1.0",
+		PullRequest: &context.PullRequest{
+			Base:   gittest.DefaultBranch,
+			Branch: "uplift/release-v0.1.0",
+		},
+		SCM: context.SCM{
+			Provider: context.GitHub,
+			APIURL:   srv.URL,
+			Repo:     "owner/repo",
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"title": "ci(uplift): uplifted for version v0.1.0",
+		"body":  "## v0.1.0",
+		"head":  "uplift/release-v0.1.0",
+		"base":  gittest.DefaultBranch,
+	}, body)
+	assert.Equal(t, 1, ctx.PullRequest.Number)
+	assert.Equal(t, "https://github.com/owner/repo/pull/1", ctx.PullRequest.URL)
+}
+
+func TestRun_NoChangesCommitted(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git checkout -q -b uplift/release-v0.1.0")
+
+	ctx := &context.Context{
+		PullRequest: &context.PullRequest{
+			Base:   gittest.DefaultBranch,
+			Branch: "uplift/release-v0.1.0",
+		},
+		SCM: context.SCM{Provider: context.GitHub},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, ctx.PullRequest.URL)
+}
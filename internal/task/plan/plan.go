@@ -0,0 +1,153 @@
+package plan
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	git "github.com/purpleclay/gitz"
+)
+
+var (
+	//go:embed template/text.tmpl
+	textTpl string
+
+	//go:embed template/markdown.tmpl
+	markdownTpl string
+
+	textTplBody     = template.Must(template.New("text").Parse(textTpl))
+	markdownTplBody = template.Must(template.New("markdown").Parse(markdownTpl))
+)
+
+type report struct {
+	Released  bool
+	Current   string
+	Next      string
+	Increment semver.Increment
+	Tag       string
+	TagType   string
+	Commit    string
+	Commits   []git.LogEntry
+	Files     []context.FileDiff
+	Changelog string
+}
+
+// Task for reporting the outcome of a release that has been planned
+// without writing any changes to the repository
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "reporting release plan"
+}
+
+// Skip running the task if no plan has been captured
+func (t Task) Skip(ctx *context.Context) bool {
+	return ctx.Plan == nil
+}
+
+// Run the task, writing a consolidated report of the planned release to
+// stdout and optionally to a markdown file
+func (t Task) Run(ctx *context.Context) error {
+	rpt, err := buildReport(ctx)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := textTplBody.Execute(&buf, rpt); err != nil {
+		return err
+	}
+	fmt.Fprint(ctx.Out, buf.String())
+
+	if ctx.Plan.Markdown == "" {
+		return nil
+	}
+
+	buf.Reset()
+	if err := markdownTplBody.Execute(&buf, rpt); err != nil {
+		return err
+	}
+
+	log.WithField("file", ctx.Plan.Markdown).Info("writing release plan as markdown")
+	return os.WriteFile(ctx.Plan.Markdown, buf.Bytes(), 0o644)
+}
+
+func buildReport(ctx *context.Context) (report, error) {
+	current := ctx.CurrentVersion.Raw
+	if current == "" {
+		current = "none"
+	}
+
+	rpt := report{
+		Released: !ctx.NoVersionChanged && ctx.NextVersion.Raw != "",
+		Current:  current,
+	}
+
+	if !rpt.Released {
+		return rpt, nil
+	}
+
+	commits, err := drivingCommits(ctx)
+	if err != nil {
+		return report{}, err
+	}
+
+	rpt.Next = ctx.NextVersion.Raw
+	rpt.Increment = ctx.Increment
+	rpt.Tag = ctx.NextVersion.Raw
+	rpt.TagType = "lightweight"
+	if ctx.Config.AnnotatedTags {
+		rpt.TagType = "annotated"
+	}
+	if ctx.SignTags {
+		rpt.TagType = "signed"
+	}
+	rpt.Commits = commits
+	rpt.Files = ctx.Plan.Files
+	rpt.Changelog = ctx.Plan.Changelog
+
+	// A commit is only ever made if there are changes to be staged
+	if len(rpt.Files) > 0 || rpt.Changelog != "" {
+		rpt.Commit = ctx.CommitDetails.Message
+	}
+
+	return rpt, nil
+}
+
+// drivingCommits identifies all commits since the last release that contribute
+// towards the next semantic version
+func drivingCommits(ctx *context.Context) ([]git.LogEntry, error) {
+	glog, err := ctx.GitClient.Log(git.WithRefRange(git.HeadRef, ctx.CurrentVersion.Raw))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := semver.ParseOptions{TrimHeader: ctx.Changelog.TrimHeader}
+
+	commits := []git.LogEntry{}
+	for _, c := range glog.Commits {
+		if semver.ParseLogWithOptions([]git.LogEntry{c}, opts) == semver.NoIncrement {
+			continue
+		}
+
+		msg := c.Message
+		if ctx.Changelog.TrimHeader {
+			msg = msg[semver.FindStartIdx(msg):]
+		}
+		if idx := strings.Index(msg, "\n"); idx > -1 {
+			msg = strings.TrimSpace(msg[:idx])
+		}
+		c.Message = msg
+
+		commits = append(commits, c)
+	}
+
+	return commits, nil
+}
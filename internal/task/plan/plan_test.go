@@ -0,0 +1,144 @@
+package plan
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "reporting release plan", Task{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	assert.True(t, Task{}.Skip(&context.Context{}))
+	assert.False(t, Task{}.Skip(&context.Context{Plan: &context.Plan{}}))
+}
+
+func TestRun(t *testing.T) {
+	log := `fix: a bug fix
+docs: update docs
+feat: a new feature
+(tag: v0.1.0) feat: initial release`
+	gittest.InitRepository(t, gittest.WithLog(log))
+	hashes := hashLookup(t, gittest.Log(t))
+
+	var buf bytes.Buffer
+	ctx := &context.Context{
+		Out:            &buf,
+		Config:         config.Uplift{AnnotatedTags: true},
+		CurrentVersion: semver.Version{Raw: "v0.1.0"},
+		NextVersion:    semver.Version{Raw: "v0.2.0"},
+		Increment:      semver.MinorIncrement,
+		CommitDetails: git.CommitDetails{
+			Message: "ci(uplift): uplifted for version v0.2.0",
+		},
+		Plan: &context.Plan{
+			Files: []context.FileDiff{
+				{
+					Path: "test.txt",
+					Diff: `--- a/test.txt
++++ b/test.txt
+@@ -1 +1 @@
+-version: v0.1.0
++version: v0.2.0
+`,
+				},
+			},
+			Changelog: "## v0.2.0 - 2023-01-01\n\n- `abcdef1` feat: a new feature\n",
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	expected := `Release Plan
+============
+
+Version:    v0.1.0 -> v0.2.0 (Minor)
+Tag:        v0.2.0 (annotated)
+Commit:     ci(uplift): uplifted for version v0.2.0
+
+Commits (2):
+  ` + hashes["fix: a bug fix"] + ` fix: a bug fix
+  ` + hashes["feat: a new feature"] + ` feat: a new feature
+
+Files (1):
+--- a/test.txt
++++ b/test.txt
+@@ -1 +1 @@
+-version: v0.1.0
++version: v0.2.0
+
+Changelog:
+## v0.2.0 - 2023-01-01
+
+- ` + "`abcdef1`" + ` feat: a new feature
+
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestRun_NoRelease(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := &context.Context{
+		Out:              &buf,
+		CurrentVersion:   semver.Version{Raw: "v0.1.0"},
+		NoVersionChanged: true,
+		Plan:             &context.Plan{},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, `Release Plan
+============
+
+No release detected, version remains at v0.1.0
+`, buf.String())
+}
+
+func TestRun_Markdown(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("feat: a new feature"))
+
+	var buf bytes.Buffer
+	ctx := &context.Context{
+		Out:         &buf,
+		NextVersion: semver.Version{Raw: "v0.1.0"},
+		Increment:   semver.MinorIncrement,
+		Plan: &context.Plan{
+			Markdown: "plan.md",
+		},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("plan.md")
+	require.NoError(t, err)
+
+	md := string(data)
+	assert.Contains(t, md, "## Uplift Release Plan")
+	assert.Contains(t, md, "| **Version** | `none` → `v0.1.0` |")
+	assert.Contains(t, md, "| **Commit** | no changes to commit |")
+	assert.Contains(t, md, "No files will be bumped")
+	assert.Contains(t, md, "No changelog will be generated")
+}
+
+func hashLookup(t *testing.T, log []gittest.LogEntry) map[string]string {
+	t.Helper()
+
+	hashes := map[string]string{}
+	for _, entry := range log {
+		hashes[entry.Message] = entry.AbbrevHash
+	}
+	return hashes
+}
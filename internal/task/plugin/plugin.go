@@ -0,0 +1,219 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/task/changelog"
+	"github.com/gembaadvantage/uplift/internal/task/hook"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+)
+
+// Stages at which a plugin can be executed, these mirror the entry points
+// available to hooks
+const (
+	StageBefore          = "before"
+	StageBeforeBump      = "beforeBump"
+	StageAfterBump       = "afterBump"
+	StageBeforeChangelog = "beforeChangelog"
+	StageAfterChangelog  = "afterChangelog"
+	StageBeforeTag       = "beforeTag"
+	StageAfterTag        = "afterTag"
+	StageAfter           = "after"
+)
+
+// Task for executing any external plugins configured for a stage of
+// the release workflow. If a name is provided, only the plugin with that
+// name is executed, regardless of its stage
+type Task struct {
+	Stage string
+	Name  string
+}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	if t.Name != "" {
+		return fmt.Sprintf("%s plugin", t.Name)
+	}
+	return fmt.Sprintf("%s plugins", t.Stage)
+}
+
+// Skip running the task if no plugins are configured for the stage
+func (t Task) Skip(ctx *context.Context) bool {
+	return len(t.plugins(ctx)) == 0
+}
+
+// Run the task, executing each plugin in the order it is configured and
+// applying any changes it requests before executing the next
+func (t Task) Run(ctx *context.Context) error {
+	for _, p := range t.plugins(ctx) {
+		log.WithField("plugin", p.Name).Info("running")
+
+		rel := hook.NewRelease(ctx)
+		req, err := newRequest(ctx, p.Stage, rel)
+		if err != nil {
+			return err
+		}
+		req.Config = p.With
+
+		pctx, span := trace.Start(ctx.Context, p.Name+" plugin")
+		resp, err := Exec(pctx, p, req, ExecOptions{
+			Debug: ctx.Debug,
+			Env:   rel.Env(),
+		})
+		span.End(err)
+		if err != nil {
+			return err
+		}
+
+		if err := apply(ctx, p, resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t Task) plugins(ctx *context.Context) []config.Plugin {
+	var ps []config.Plugin
+	for _, p := range ctx.Config.Plugins {
+		if t.Name != "" {
+			if p.Name == t.Name {
+				ps = append(ps, p)
+			}
+			continue
+		}
+
+		if t.Stage != "" && p.Stage == t.Stage {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+func newRequest(ctx *context.Context, stage string, rel hook.Release) (Request, error) {
+	var staged []string
+	if ctx.GitClient != nil {
+		var err error
+		if staged, err = ctx.GitClient.Staged(); err != nil {
+			return Request{}, err
+		}
+	}
+
+	return Request{
+		Version: ProtocolVersion,
+		Stage:   stage,
+		Context: Context{
+			CurrentVersion: rel.CurrentVersion,
+			NextVersion:    rel.NextVersion,
+			Increment:      rel.Increment,
+			Prerelease:     rel.Prerelease,
+			Metadata:       rel.Metadata,
+			Branch:         rel.Branch,
+			SCMProvider:    rel.SCMProvider,
+			ChangelogPath:  rel.ChangelogPath,
+			ReleaseNotes:   ctx.ReleaseNotes,
+			StagedFiles:    staged,
+			DryRun:         ctx.DryRun,
+			NoPush:         ctx.NoPush,
+			SkipBumps:      ctx.SkipBumps,
+			SkipChangelog:  ctx.SkipChangelog,
+		},
+	}, nil
+}
+
+func apply(ctx *context.Context, p config.Plugin, resp Response) error {
+	if len(resp.StageFiles) > 0 {
+		if ctx.DryRun || ctx.NoStage {
+			log.WithField("plugin", p.Name).Info("skipped staging of files")
+		} else {
+			if _, err := ctx.GitClient.Stage(git.WithPathSpecs(resp.StageFiles...)); err != nil {
+				return err
+			}
+			ctx.Journal.Staged(resp.StageFiles...)
+			log.WithField("plugin", p.Name).Debug("staged files")
+		}
+	}
+
+	if resp.ReleaseNotes != nil {
+		if err := replaceReleaseNotes(ctx, p, *resp.ReleaseNotes); err != nil {
+			return err
+		}
+		log.WithField("plugin", p.Name).Debug("updated release notes")
+	}
+
+	if resp.SkipBumps {
+		ctx.SkipBumps = true
+		log.WithField("plugin", p.Name).Info("skipping file bumps")
+	}
+
+	if resp.SkipChangelog {
+		ctx.SkipChangelog = true
+		log.WithField("plugin", p.Name).Info("skipping changelog")
+	}
+
+	if resp.NoPush {
+		ctx.NoPush = true
+		log.WithField("plugin", p.Name).Info("skipping push to remote")
+	}
+
+	return nil
+}
+
+// replaceReleaseNotes swaps the release notes generated by the changelog
+// task, along with their entry within the changelog. Release notes cannot
+// be replaced before they have been generated, as they would be overwritten
+func replaceReleaseNotes(ctx *context.Context, p config.Plugin, notes string) error {
+	switch p.Stage {
+	case StageBefore, StageBeforeBump, StageAfterBump, StageBeforeChangelog:
+		return fmt.Errorf("plugin %s cannot replace release notes at stage %s, they have not been generated yet", p.Name, p.Stage)
+	}
+
+	prev := ctx.ReleaseNotes
+	ctx.ReleaseNotes = notes
+	if prev == "" {
+		return nil
+	}
+
+	if ctx.Plan != nil {
+		ctx.Plan.Changelog = notes
+	}
+
+	if ctx.DryRun || ctx.Changelog.DiffOnly {
+		return nil
+	}
+
+	data, err := os.ReadFile(changelog.MarkdownFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !strings.Contains(string(data), prev) {
+		log.WithField("plugin", p.Name).Warn("release notes not found within changelog, only replacing release notes")
+		return nil
+	}
+
+	ctx.Journal.FileWritten(changelog.MarkdownFile)
+	updated := strings.Replace(string(data), prev, notes, 1)
+	if err := os.WriteFile(changelog.MarkdownFile, []byte(updated), 0o644); err != nil {
+		return err
+	}
+
+	if ctx.NoStage {
+		return nil
+	}
+
+	if _, err := ctx.GitClient.Stage(git.WithPathSpecs(changelog.MarkdownFile)); err != nil {
+		return err
+	}
+	ctx.Journal.Staged(changelog.MarkdownFile)
+	return nil
+}
@@ -0,0 +1,209 @@
+package plugin
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/journal"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "afterBump plugins", Task{Stage: StageAfterBump}.String())
+}
+
+func TestSkip(t *testing.T) {
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Plugins: []config.Plugin{{Name: "publish", Cmd: "publish", Stage: StageAfterTag}},
+		},
+	}
+
+	assert.True(t, Task{Stage: StageAfterBump}.Skip(ctx))
+	assert.False(t, Task{Stage: StageAfterTag}.Skip(ctx))
+}
+
+func TestRun(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("artifact.txt"))
+
+	plugin := script(t, `cat > request.json
+echo '{"version": 1, "stageFiles": ["artifact.txt"], "skipChangelog": true}'`)
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Plugins: []config.Plugin{
+				{
+					Name:  "publish",
+					Cmd:   plugin,
+					Stage: StageAfterBump,
+					With:  map[string]interface{}{"registry": "internal"},
+				},
+			},
+		},
+		CurrentVersion: semver.Version{Raw: "v1.0.0"},
+		NextVersion:    semver.Version{Raw: "v1.1.0"},
+		Increment:      semver.MinorIncrement,
+		GitClient:      trace.NewGitClient(gitc, nil),
+		Journal:        &journal.Journal{},
+	}
+
+	err = Task{Stage: StageAfterBump}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.True(t, ctx.SkipChangelog)
+	assert.False(t, ctx.SkipBumps)
+	assert.False(t, ctx.NoPush)
+
+	staged, err := gitc.Staged()
+	require.NoError(t, err)
+	assert.Contains(t, staged, "artifact.txt")
+	require.Len(t, ctx.Journal.Entries(), 1)
+
+	data, err := os.ReadFile("request.json")
+	require.NoError(t, err)
+
+	var req Request
+	require.NoError(t, json.Unmarshal(data, &req))
+	assert.Equal(t, ProtocolVersion, req.Version)
+	assert.Equal(t, StageAfterBump, req.Stage)
+	assert.Equal(t, "v1.0.0", req.Context.CurrentVersion)
+	assert.Equal(t, "v1.1.0", req.Context.NextVersion)
+	assert.Equal(t, "Minor", req.Context.Increment)
+	assert.Equal(t, "internal", req.Config["registry"])
+}
+
+func TestRun_DryRun(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithFiles("artifact.txt"))
+
+	plugin := script(t, `echo '{"version": 1, "stageFiles": ["artifact.txt"], "noPush": true}'`)
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Plugins: []config.Plugin{{Name: "publish", Cmd: plugin, Stage: StageAfterBump}},
+		},
+		DryRun:    true,
+		GitClient: trace.NewGitClient(gitc, nil),
+	}
+
+	err = Task{Stage: StageAfterBump}.Run(ctx)
+	require.NoError(t, err)
+	assert.True(t, ctx.NoPush)
+
+	staged, err := gitc.Staged()
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+}
+
+func TestRun_ChainsPlugins(t *testing.T) {
+	gittest.InitRepository(t)
+
+	first := script(t, `echo '{"version": 1, "releaseNotes": "first"}'`)
+	second := script(t, `cat > request.json`)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Plugins: []config.Plugin{
+				{Name: "first", Cmd: first, Stage: StageAfterChangelog},
+				{Name: "second", Cmd: second, Stage: StageAfterChangelog},
+			},
+		},
+	}
+
+	err := Task{Stage: StageAfterChangelog}.Run(ctx)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("request.json")
+	require.NoError(t, err)
+
+	var req Request
+	require.NoError(t, json.Unmarshal(data, &req))
+	assert.Equal(t, "first", req.Context.ReleaseNotes)
+}
+
+func TestRun_ReleaseNotesBeforeChangelog(t *testing.T) {
+	gittest.InitRepository(t)
+
+	plugin := script(t, `echo '{"version": 1, "releaseNotes": "## Notes"}'`)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Plugins: []config.Plugin{{Name: "notes", Cmd: plugin, Stage: StageBeforeChangelog}},
+		},
+	}
+
+	err := Task{Stage: StageBeforeChangelog}.Run(ctx)
+	require.EqualError(t, err,
+		"plugin notes cannot replace release notes at stage beforeChangelog, they have not been generated yet")
+}
+
+func TestRun_ReplacesReleaseNotesInChangelog(t *testing.T) {
+	gittest.InitRepository(t)
+
+	generated := "## v1.1.0\n\n- feat: generated"
+	changelog := "# Changelog\n\n" + generated + "\n\n## v1.0.0\n\n- feat: generated\n"
+	require.NoError(t, os.WriteFile("CHANGELOG.md", []byte(changelog), 0o644))
+
+	plugin := script(t, `echo '{"version": 1, "releaseNotes": "## v1.1.0\\n\\n- feat: replaced"}'`)
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Plugins: []config.Plugin{{Name: "notes", Cmd: plugin, Stage: StageAfterChangelog}},
+		},
+		ReleaseNotes: generated,
+		GitClient:    trace.NewGitClient(gitc, nil),
+		Journal:      &journal.Journal{},
+	}
+
+	err = Task{Stage: StageAfterChangelog}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "## v1.1.0\n\n- feat: replaced", ctx.ReleaseNotes)
+
+	data, err := os.ReadFile("CHANGELOG.md")
+	require.NoError(t, err)
+	assert.Equal(t, "# Changelog\n\n## v1.1.0\n\n- feat: replaced\n\n## v1.0.0\n\n- feat: generated\n", string(data))
+
+	staged, err := gitc.Staged()
+	require.NoError(t, err)
+	assert.Contains(t, staged, "CHANGELOG.md")
+	require.Len(t, ctx.Journal.Entries(), 2)
+}
+
+func script(t *testing.T, body string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "plugin.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o755))
+	return path
+}
+
+func TestSkip_Named(t *testing.T) {
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Plugins: []config.Plugin{{Name: "publish", Cmd: "publish"}},
+		},
+	}
+
+	assert.Equal(t, "publish plugin", Task{Name: "publish"}.String())
+	assert.False(t, Task{Name: "publish"}.Skip(ctx))
+	assert.True(t, Task{Name: "unknown"}.Skip(ctx))
+	assert.True(t, Task{Stage: StageAfter}.Skip(ctx))
+}
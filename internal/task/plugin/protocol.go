@@ -0,0 +1,133 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/config"
+)
+
+// ProtocolVersion identifies the version of the JSON protocol used to communicate
+// with a plugin. Any breaking change to either the [Request] or [Response] will
+// result in a new version
+const ProtocolVersion = 1
+
+// Request is written as JSON to the stdin of a plugin
+type Request struct {
+	Version int                    `json:"version"`
+	Stage   string                 `json:"stage"`
+	Context Context                `json:"context"`
+	Config  map[string]interface{} `json:"config,omitempty"`
+}
+
+// Context contains a snapshot of the current release, serialized for a plugin
+type Context struct {
+	CurrentVersion string   `json:"currentVersion"`
+	NextVersion    string   `json:"nextVersion"`
+	Increment      string   `json:"increment"`
+	Prerelease     string   `json:"prerelease"`
+	Metadata       string   `json:"metadata"`
+	Branch         string   `json:"branch"`
+	SCMProvider    string   `json:"scmProvider"`
+	ChangelogPath  string   `json:"changelogPath"`
+	ReleaseNotes   string   `json:"releaseNotes"`
+	StagedFiles    []string `json:"stagedFiles"`
+	DryRun         bool     `json:"dryRun"`
+	NoPush         bool     `json:"noPush"`
+	SkipBumps      bool     `json:"skipBumps"`
+	SkipChangelog  bool     `json:"skipChangelog"`
+}
+
+// Response is read as JSON from the stdout of a plugin. Every field is optional,
+// and an empty response is treated as a plugin requesting no changes
+type Response struct {
+	Version       int      `json:"version"`
+	StageFiles    []string `json:"stageFiles"`
+	ReleaseNotes  *string  `json:"releaseNotes"`
+	SkipBumps     bool     `json:"skipBumps"`
+	SkipChangelog bool     `json:"skipChangelog"`
+	NoPush        bool     `json:"noPush"`
+}
+
+// ExecOptions provides a way of customising the execution of a plugin
+type ExecOptions struct {
+	Debug bool
+	Env   []string
+}
+
+// Exec will execute a plugin, writing the request to its stdin and decoding the
+// response from its stdout. Anything a plugin writes to stderr is treated as
+// logging, and only shown in debug mode
+func Exec(ctx context.Context, p config.Plugin, req Request, opts ExecOptions) (Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	in, err := json.Marshal(req)
+	if err != nil {
+		return Response{}, err
+	}
+
+	var stdout bytes.Buffer
+	var stderr io.Writer = io.Discard
+	if opts.Debug {
+		stderr = os.Stderr
+	}
+
+	cmd := exec.CommandContext(ctx, p.Cmd, p.Args...)
+	cmd.Env = append(os.Environ(), opts.Env...)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = stderr
+
+	// Any child processes of a terminated plugin may still hold its output open
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return Response{}, fmt.Errorf("plugin %s timed out after %s: %w", p.Name, p.Timeout, err)
+		}
+		return Response{}, fmt.Errorf("plugin %s failed: %w", p.Name, err)
+	}
+
+	out := bytes.TrimSpace(stdout.Bytes())
+	if len(out) == 0 {
+		log.WithField("plugin", p.Name).Debug("plugin returned an empty response")
+		return Response{Version: ProtocolVersion}, nil
+	}
+
+	var resp Response
+	dec := json.NewDecoder(bytes.NewReader(out))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&resp); err != nil {
+		return Response{}, fmt.Errorf("plugin %s returned an invalid response: %w", p.Name, err)
+	}
+
+	if resp.Version != ProtocolVersion {
+		return Response{}, fmt.Errorf("plugin %s responded with unsupported protocol version %d, expected %d",
+			p.Name, resp.Version, ProtocolVersion)
+	}
+
+	for _, f := range resp.StageFiles {
+		if strings.TrimSpace(f) == "" {
+			return Response{}, fmt.Errorf("plugin %s requested an empty file path to be staged", p.Name)
+		}
+	}
+
+	return resp, nil
+}
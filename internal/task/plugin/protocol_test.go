@@ -0,0 +1,91 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExec_EmptyResponse(t *testing.T) {
+	p := config.Plugin{Name: "noop", Cmd: script(t, "cat > /dev/null")}
+
+	resp, err := Exec(context.Background(), p, Request{Version: ProtocolVersion}, ExecOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, Response{Version: ProtocolVersion}, resp)
+}
+
+func TestExec_Env(t *testing.T) {
+	p := config.Plugin{Name: "env", Cmd: script(t, `echo "{\"version\": 1, \"releaseNotes\": \"$UPLIFT_NEXT_VERSION\"}"`)}
+
+	resp, err := Exec(context.Background(), p, Request{}, ExecOptions{Env: []string{"UPLIFT_NEXT_VERSION=v1.0.0"}})
+	require.NoError(t, err)
+	require.NotNil(t, resp.ReleaseNotes)
+	assert.Equal(t, "v1.0.0", *resp.ReleaseNotes)
+}
+
+func TestExec_Args(t *testing.T) {
+	p := config.Plugin{
+		Name: "args",
+		Cmd:  script(t, `echo "{\"version\": 1, \"releaseNotes\": \"$1 $2\"}"`),
+		Args: []string{"--registry", "internal"},
+	}
+
+	resp, err := Exec(context.Background(), p, Request{}, ExecOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, resp.ReleaseNotes)
+	assert.Equal(t, "--registry internal", *resp.ReleaseNotes)
+}
+
+func TestExec_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		plugin config.Plugin
+		err    string
+	}{
+		{
+			name:   "Failed",
+			plugin: config.Plugin{Name: "broken", Cmd: script(t, "exit 1")},
+			err:    "plugin broken failed: exit status 1",
+		},
+		{
+			name:   "NotFound",
+			plugin: config.Plugin{Name: "missing", Cmd: "uplift-plugin-does-not-exist"},
+			err:    "plugin missing failed",
+		},
+		{
+			name:   "InvalidJSON",
+			plugin: config.Plugin{Name: "invalid", Cmd: script(t, "echo 'not json'")},
+			err:    "plugin invalid returned an invalid response",
+		},
+		{
+			name:   "UnknownField",
+			plugin: config.Plugin{Name: "unknown", Cmd: script(t, `echo '{"version": 1, "unknown": true}'`)},
+			err:    "plugin unknown returned an invalid response",
+		},
+		{
+			name:   "UnsupportedVersion",
+			plugin: config.Plugin{Name: "future", Cmd: script(t, `echo '{"version": 2}'`)},
+			err:    "plugin future responded with unsupported protocol version 2, expected 1",
+		},
+		{
+			name:   "EmptyStagedFile",
+			plugin: config.Plugin{Name: "empty", Cmd: script(t, `echo '{"version": 1, "stageFiles": [" "]}'`)},
+			err:    "plugin empty requested an empty file path to be staged",
+		},
+		{
+			name:   "Timeout",
+			plugin: config.Plugin{Name: "slow", Cmd: script(t, "sleep 5"), Timeout: 100 * time.Millisecond},
+			err:    "plugin slow timed out after 100ms",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Exec(context.Background(), tt.plugin, Request{}, ExecOptions{})
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
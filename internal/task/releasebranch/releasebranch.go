@@ -0,0 +1,54 @@
+package releasebranch
+
+import (
+	"errors"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/context"
+)
+
+// ErrDetachedHead is raised when attempting to create a release branch from a
+// repository that is in a detached HEAD state
+var ErrDetachedHead = errors.New("a release branch cannot be created when the repository is in a detached HEAD state")
+
+// Task for creating and checking out a dedicated release branch. Used when
+// a release is published through a pull request
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "creating release branch"
+}
+
+// Skip running the task if a release is not published through a pull request
+func (t Task) Skip(ctx *context.Context) bool {
+	return ctx.PullRequest == nil || ctx.DryRun || ctx.NoVersionChanged
+}
+
+// Run the task, creating a release branch from the current branch. Any
+// uncommitted changes are carried across to the release branch
+func (t Task) Run(ctx *context.Context) error {
+	base, err := ctx.GitClient.Exec("git branch --show-current")
+	if err != nil {
+		return err
+	}
+
+	if base == "" {
+		return ErrDetachedHead
+	}
+
+	branch := ctx.PullRequest.Prefix + ctx.NextVersion.Raw
+	if _, err := ctx.GitClient.Exec("git checkout -q -b " + branch); err != nil {
+		return err
+	}
+	ctx.Journal.Branched(branch, base)
+
+	log.WithFields(log.Fields{
+		"branch": branch,
+		"base":   base,
+	}).Info("created release branch")
+
+	ctx.PullRequest.Base = base
+	ctx.PullRequest.Branch = branch
+	return nil
+}
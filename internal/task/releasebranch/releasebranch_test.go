@@ -0,0 +1,80 @@
+package releasebranch
+
+import (
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/journal"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "creating release branch", Task{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  *context.Context
+	}{
+		{
+			name: "NotPullRequestMode",
+			ctx:  &context.Context{},
+		},
+		{
+			name: "DryRun",
+			ctx: &context.Context{
+				PullRequest: &context.PullRequest{},
+				DryRun:      true,
+			},
+		},
+		{
+			name: "NoVersionChanged",
+			ctx: &context.Context{
+				PullRequest:      &context.PullRequest{},
+				NoVersionChanged: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, Task{}.Skip(tt.ctx))
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithStagedFiles("test.txt"))
+
+	ctx := &context.Context{
+		NextVersion: semver.Version{Raw: "v0.1.0"},
+		PullRequest: &context.PullRequest{Prefix: "uplift/release-"},
+		Journal:     &journal.Journal{},
+	}
+
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "uplift/release-v0.1.0", gittest.MustExec(t, "git branch --show-current"))
+	assert.Equal(t, []string{"A  test.txt"}, gittest.PorcelainStatus(t))
+	assert.Equal(t, gittest.DefaultBranch, ctx.PullRequest.Base)
+	assert.Equal(t, "uplift/release-v0.1.0", ctx.PullRequest.Branch)
+
+	entries := ctx.Journal.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, journal.Branched, entries[0].Kind)
+}
+
+func TestRun_DetachedHead(t *testing.T) {
+	gittest.InitRepository(t)
+	gittest.MustExec(t, "git checkout -q --detach")
+
+	err := Task{}.Run(&context.Context{
+		NextVersion: semver.Version{Raw: "v0.1.0"},
+		PullRequest: &context.PullRequest{Prefix: "uplift/release-"},
+	})
+	require.ErrorIs(t, err, ErrDetachedHead)
+}
@@ -23,11 +23,18 @@ SOFTWARE.
 package task
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/apex/log"
 	"github.com/apex/log/handlers/cli"
+	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/logging"
+	"github.com/gembaadvantage/uplift/internal/task/hook"
+	"github.com/gembaadvantage/uplift/internal/trace"
 )
 
 const (
@@ -54,26 +61,225 @@ type Runner interface {
 // Execute a series of tasks, providing the [context.Context] to each. Before executing
 // a task, a precondition check is performed, identifying if the task should be skipped
 // or not. Tasks that are skipped, will automatically have [skipped] appended to their
-// task name. Execution will be aborted upon the first encountered error
+// task name. Execution will be aborted upon the first encountered error. Any cleanup
+// registered within the [context.Context] is always run once execution completes. The
+// duration of every task is traced, and can be exported once execution completes
 func Execute(ctx *context.Context, tasks []Runner) error {
+	return executePipeline(ctx, tasks, false)
+}
+
+// ExecuteWithHooks executes a series of tasks in the same way as [Execute], but also
+// triggers any onError hooks upon the first encountered error, and any always hooks
+// once execution completes. Should only be used by pipelines that modify a repository
+func ExecuteWithHooks(ctx *context.Context, tasks []Runner) error {
+	return executePipeline(ctx, tasks, true)
+}
+
+func executePipeline(ctx *context.Context, tasks []Runner, hooks bool) error {
+	defer cleanup(ctx)
+
+	tracer := trace.New()
+	parent := ctx.Context
+
+	var root *trace.Span
+	ctx.Context, root = trace.Start(trace.WithTracer(parent, tracer), trace.ServiceName)
+
+	rel := hook.Release{}
+	err := execute(ctx, tasks, &rel)
+	if hooks {
+		err = execPipelineHooks(ctx, rel, err)
+	}
+
+	root.End(err)
+	ctx.Context = parent
+	report(ctx, tracer.Spans())
+
+	return err
+}
+
+// execPipelineHooks runs any onError hooks if execution failed, followed by
+// any always hooks. A failing hook never masks the original error
+func execPipelineHooks(ctx *context.Context, rel hook.Release, err error) error {
+	if err != nil {
+		if hErr := execHooks(ctx, "onError hooks", onErrorHooks(ctx), rel); hErr != nil {
+			log.WithError(hErr).Warn("onError hooks failed")
+		}
+	}
+
+	if hErr := execHooks(ctx, "always hooks", alwaysHooks(ctx), rel); hErr != nil {
+		if err != nil {
+			log.WithError(hErr).Warn("always hooks failed")
+		} else {
+			err = hErr
+		}
+	}
+	return err
+}
+
+func execute(ctx *context.Context, tasks []Runner, rel *hook.Release) error {
 	for _, t := range tasks {
 		defer func() {
 			// Ensure padding is automatically reset
-			cli.Default.Padding = DefaultPadding
+			pad(DefaultPadding)
 		}()
 
-		cli.Default.Padding = DefaultPadding
+		pad(DefaultPadding)
+		logging.StartTask(t.String())
 
 		if t.Skip(ctx) {
 			log.Debug(fmt.Sprintf("(skipped) %s", t.String()))
-		} else {
-			log.Info(t.String())
-			cli.Default.Padding = PrettyPadding
-			if err := t.Run(ctx); err != nil {
-				return err
-			}
+			logging.EndTask(0, logging.OutcomeSkipped, nil)
+			continue
 		}
+
+		log.Info(t.String())
+		pad(PrettyPadding)
+
+		start := time.Now()
+		if err := run(ctx, t); err != nil {
+			logging.EndTask(time.Since(start), logging.OutcomeFailed, err)
+			rel.FailedTask = t.String()
+			rel.Error = err.Error()
+			return err
+		}
+		logging.EndTask(time.Since(start), logging.OutcomeSuccess, nil)
 	}
 
 	return nil
 }
+
+// run the task within its own span, ensuring any hooks or git operations
+// executed by the task are traced as its children
+func run(ctx *context.Context, t Runner) error {
+	parent := ctx.Context
+	defer func() {
+		ctx.Context = parent
+	}()
+
+	var span *trace.Span
+	ctx.Context, span = trace.Start(parent, t.String())
+
+	err := t.Run(ctx)
+	span.End(err)
+	return err
+}
+
+func execHooks(ctx *context.Context, name string, hooks []config.Hook, failure hook.Release) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	defer func() {
+		pad(DefaultPadding)
+	}()
+
+	pad(DefaultPadding)
+	logging.StartTask(name)
+	log.Info(name)
+	pad(PrettyPadding)
+
+	rel := hook.NewRelease(ctx)
+	rel.FailedTask = failure.FailedTask
+	rel.Error = failure.Error
+
+	start := time.Now()
+	hctx, span := trace.Start(ctx.Context, name)
+	err := hook.Exec(hctx, hooks, hook.ExecOptions{
+		DryRun:  ctx.DryRun,
+		Debug:   ctx.Debug,
+		Env:     ctx.Config.Env,
+		Release: rel,
+	})
+	span.End(err)
+
+	outcome := logging.OutcomeSuccess
+	if err != nil {
+		outcome = logging.OutcomeFailed
+	}
+	logging.EndTask(time.Since(start), outcome, err)
+
+	return err
+}
+
+// pad indents all logging when written to a terminal using the cli format
+func pad(padding int) {
+	if logging.Pretty() {
+		cli.Default.Padding = padding
+	}
+}
+
+// report the duration of every traced span. When debugging, a timing table is
+// written within a terminal, otherwise the timing of each span is logged as a
+// structured entry. Spans are exported if requested. A failed export never
+// fails the execution
+func report(ctx *context.Context, spans []trace.Span) {
+	if ctx.Debug && !logging.Pretty() {
+		for _, s := range spans {
+			status := "ok"
+			if s.Error != "" {
+				status = "failed"
+			}
+
+			log.WithFields(log.Fields{
+				"span":     s.Name,
+				"id":       s.ID,
+				"parent":   s.ParentID,
+				"duration": s.Duration().Round(time.Millisecond).String(),
+				"status":   status,
+			}).Debug("timing")
+		}
+	}
+
+	if ctx.Debug && logging.Pretty() {
+		var buf bytes.Buffer
+		if err := trace.WriteTable(&buf, spans); err == nil {
+			pad(DefaultPadding)
+			log.Debug("timings")
+			pad(PrettyPadding)
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				log.Debug(line)
+			}
+			pad(DefaultPadding)
+		}
+	}
+
+	if ctx.TraceFile != "" {
+		if err := trace.WriteFile(ctx.TraceFile, spans); err != nil {
+			log.WithError(err).Warn("failed to write trace")
+		} else {
+			log.WithField("path", ctx.TraceFile).Debug("written trace")
+		}
+	}
+
+	if ctx.TraceEndpoint != "" {
+		if err := trace.Export(ctx.Context, ctx.TraceEndpoint, spans); err != nil {
+			log.WithError(err).Warn("failed to export trace")
+		} else {
+			log.WithField("endpoint", ctx.TraceEndpoint).Debug("exported trace")
+		}
+	}
+}
+
+func onErrorHooks(ctx *context.Context) []config.Hook {
+	if ctx.Config.Hooks == nil {
+		return nil
+	}
+	return ctx.Config.Hooks.OnError
+}
+
+func alwaysHooks(ctx *context.Context) []config.Hook {
+	if ctx.Config.Hooks == nil {
+		return nil
+	}
+	return ctx.Config.Hooks.Always
+}
+
+func cleanup(ctx *context.Context) {
+	// Run in reverse order of registration, mirroring the behavior of defer
+	for i := len(ctx.Cleanup) - 1; i >= 0; i-- {
+		if err := ctx.Cleanup[i](); err != nil {
+			log.WithError(err).Warn("failed to clean up")
+		}
+	}
+	ctx.Cleanup = nil
+}
@@ -23,11 +23,22 @@ SOFTWARE.
 package task_test
 
 import (
+	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/cli"
+	"github.com/apex/log/handlers/memory"
+	"github.com/gembaadvantage/uplift/internal/config"
 	"github.com/gembaadvantage/uplift/internal/context"
 	"github.com/gembaadvantage/uplift/internal/task"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -65,6 +76,296 @@ func TestExecute_Skips(t *testing.T) {
 	m.AssertNotCalled(t, "Run")
 }
 
+func TestExecute_Cleanup(t *testing.T) {
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(errors.New("unexpected error"))
+	m.On("Skip", mock.Anything).Return(false)
+
+	var order []int
+	ctx := &context.Context{
+		Cleanup: []func() error{
+			func() error { order = append(order, 1); return nil },
+			func() error { order = append(order, 2); return errors.New("cleanup failed") },
+		},
+	}
+
+	err := task.Execute(ctx, []task.Runner{m})
+
+	require.EqualError(t, err, "unexpected error")
+	require.Equal(t, []int{2, 1}, order)
+	require.Empty(t, ctx.Cleanup)
+}
+
+func TestExecute_NoHooks(t *testing.T) {
+	gittest.InitRepository(t)
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(errors.New("unexpected error"))
+	m.On("Skip", mock.Anything).Return(false)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Hooks: &config.Hooks{
+				OnError: []config.Hook{{Cmd: "touch error.out"}},
+				Always:  []config.Hook{{Cmd: "touch always.out"}},
+			},
+		},
+	}
+
+	err := task.Execute(ctx, []task.Runner{m})
+	require.EqualError(t, err, "unexpected error")
+
+	assert.NoFileExists(t, "error.out")
+	assert.NoFileExists(t, "always.out")
+}
+
+func TestExecuteWithHooks_OnErrorHooks(t *testing.T) {
+	gittest.InitRepository(t)
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(errors.New("unexpected error"))
+	m.On("Skip", mock.Anything).Return(false)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Hooks: &config.Hooks{
+				OnError: []config.Hook{{Cmd: "echo -n $UPLIFT_FAILED_TASK: $UPLIFT_ERROR > error.out"}},
+				Always:  []config.Hook{{Cmd: "echo -n {{.FailedTask}} > always.out"}},
+			},
+		},
+	}
+
+	err := task.ExecuteWithHooks(ctx, []task.Runner{m})
+	require.EqualError(t, err, "unexpected error")
+
+	data, err := os.ReadFile("error.out")
+	require.NoError(t, err)
+	assert.Equal(t, "mocked task: unexpected error", string(data))
+
+	data, err = os.ReadFile("always.out")
+	require.NoError(t, err)
+	assert.Equal(t, "mocked task", string(data))
+}
+
+func TestExecuteWithHooks_OnErrorHooksNotRunOnSuccess(t *testing.T) {
+	gittest.InitRepository(t)
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(nil)
+	m.On("Skip", mock.Anything).Return(false)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Hooks: &config.Hooks{
+				OnError: []config.Hook{{Cmd: "touch error.out"}},
+				Always:  []config.Hook{{Cmd: "touch always.out"}},
+			},
+		},
+	}
+
+	err := task.ExecuteWithHooks(ctx, []task.Runner{m})
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, "error.out")
+	assert.FileExists(t, "always.out")
+}
+
+func TestExecuteWithHooks_FailingHooksDoNotMaskError(t *testing.T) {
+	gittest.InitRepository(t)
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(errors.New("unexpected error"))
+	m.On("Skip", mock.Anything).Return(false)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Hooks: &config.Hooks{
+				OnError: []config.Hook{{Cmd: "exit 1"}},
+				Always:  []config.Hook{{Cmd: "exit 1"}},
+			},
+		},
+	}
+
+	err := task.ExecuteWithHooks(ctx, []task.Runner{m})
+	require.EqualError(t, err, "unexpected error")
+}
+
+func TestExecuteWithHooks_FailingAlwaysHooks(t *testing.T) {
+	gittest.InitRepository(t)
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(nil)
+	m.On("Skip", mock.Anything).Return(false)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Hooks: &config.Hooks{
+				Always: []config.Hook{{Cmd: "exit 1"}},
+			},
+		},
+	}
+
+	err := task.ExecuteWithHooks(ctx, []task.Runner{m})
+	require.Error(t, err)
+}
+
+func TestExecute_TraceFile(t *testing.T) {
+	gittest.InitRepository(t)
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(errors.New("unexpected error"))
+	m.On("Skip", mock.Anything).Return(false)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Hooks: &config.Hooks{
+				Always: []config.Hook{{Cmd: "echo always"}},
+			},
+		},
+		TraceFile: "trace.json",
+	}
+
+	err := task.ExecuteWithHooks(ctx, []task.Runner{m})
+	require.EqualError(t, err, "unexpected error")
+
+	data, err := os.ReadFile("trace.json")
+	require.NoError(t, err)
+
+	spans := decodeSpans(t, data)
+	require.Len(t, spans, 4)
+
+	assert.Equal(t, "uplift", spans[0].Name)
+	assert.Empty(t, spans[0].ParentSpanID)
+	assert.Equal(t, 2, spans[0].Status.Code)
+
+	assert.Equal(t, "mocked task", spans[1].Name)
+	assert.Equal(t, spans[0].SpanID, spans[1].ParentSpanID)
+	assert.Equal(t, "unexpected error", spans[1].Status.Message)
+
+	assert.Equal(t, "always hooks", spans[2].Name)
+	assert.Equal(t, spans[0].SpanID, spans[2].ParentSpanID)
+
+	assert.Equal(t, "echo always", spans[3].Name)
+	assert.Equal(t, spans[2].SpanID, spans[3].ParentSpanID)
+	assert.Equal(t, 1, spans[3].Status.Code)
+}
+
+func TestExecute_TraceEndpoint(t *testing.T) {
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(nil)
+	m.On("Skip", mock.Anything).Return(false)
+
+	err := task.Execute(&context.Context{TraceEndpoint: srv.URL}, []task.Runner{m})
+	require.NoError(t, err)
+
+	spans := decodeSpans(t, body)
+	require.Len(t, spans, 2)
+	assert.Equal(t, "mocked task", spans[1].Name)
+}
+
+func TestExecute_TracesGitOperations(t *testing.T) {
+	gittest.InitRepository(t)
+
+	ctx := context.New(config.Uplift{}, io.Discard)
+	ctx.TraceFile = "trace.json"
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		_, err := args.Get(0).(*context.Context).GitClient.Tags()
+		require.NoError(t, err)
+	})
+	m.On("Skip", mock.Anything).Return(false)
+
+	err := task.Execute(ctx, []task.Runner{m})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("trace.json")
+	require.NoError(t, err)
+
+	spans := decodeSpans(t, data)
+	require.Len(t, spans, 3)
+	assert.Equal(t, "git tag", spans[2].Name)
+	assert.Equal(t, spans[1].SpanID, spans[2].ParentSpanID)
+}
+
+func TestExecute_DebugTimingsStructured(t *testing.T) {
+	handler := memory.New()
+	log.SetHandler(handler)
+	log.SetLevel(log.DebugLevel)
+	t.Cleanup(func() {
+		log.SetHandler(cli.Default)
+		log.SetLevel(log.InfoLevel)
+	})
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(nil)
+	m.On("Skip", mock.Anything).Return(false)
+
+	err := task.Execute(&context.Context{Debug: true}, []task.Runner{m})
+	require.NoError(t, err)
+
+	var timings []*log.Entry
+	for _, e := range handler.Entries {
+		if e.Message == "timing" {
+			timings = append(timings, e)
+		}
+	}
+
+	require.Len(t, timings, 2)
+	assert.Equal(t, "uplift", timings[0].Fields.Get("span"))
+	assert.Equal(t, "mocked task", timings[1].Fields.Get("span"))
+	assert.Equal(t, timings[0].Fields.Get("id"), timings[1].Fields.Get("parent"))
+	assert.Equal(t, "ok", timings[1].Fields.Get("status"))
+}
+
+func TestExecute_TraceEndpointFailureIgnored(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	m := &MockedTask{}
+	m.On("Run", mock.Anything).Return(nil)
+	m.On("Skip", mock.Anything).Return(false)
+
+	err := task.Execute(&context.Context{TraceEndpoint: srv.URL}, []task.Runner{m})
+	require.NoError(t, err)
+}
+
+type span struct {
+	SpanID       string `json:"spanId"`
+	ParentSpanID string `json:"parentSpanId"`
+	Name         string `json:"name"`
+	Status       struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"status"`
+}
+
+func decodeSpans(t *testing.T, data []byte) []span {
+	t.Helper()
+
+	var export struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []span `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	require.NoError(t, json.Unmarshal(data, &export))
+	require.Len(t, export.ResourceSpans, 1)
+	require.Len(t, export.ResourceSpans[0].ScopeSpans, 1)
+
+	return export.ResourceSpans[0].ScopeSpans[0].Spans
+}
+
 type MockedTask struct {
 	mock.Mock
 }
@@ -74,6 +375,10 @@ func (m *MockedTask) Run(ctx *context.Context) error {
 	return args.Error(0)
 }
 
+func (m *MockedTask) String() string {
+	return "mocked task"
+}
+
 func (m *MockedTask) Skip(ctx *context.Context) bool {
 	args := m.Called(ctx)
 	return args.Bool(0)
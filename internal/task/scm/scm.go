@@ -10,7 +10,8 @@ import (
 	"github.com/gembaadvantage/uplift/internal/context"
 )
 
-type remote struct {
+// Remote contains details about the origin remote of a repository
+type Remote struct {
 	Origin string
 	Owner  string
 	Name   string
@@ -40,7 +41,7 @@ func (t Task) Run(ctx *context.Context) error {
 		return err
 	}
 
-	rem, err := parseRemote(repo.Origin)
+	rem, err := ParseRemote(repo.Origin)
 	if err != nil {
 		return err
 	}
@@ -69,7 +70,8 @@ func (t Task) Run(ctx *context.Context) error {
 	return nil
 }
 
-func parseRemote(remURL string) (remote, error) {
+// ParseRemote parses the URL of a remote, supporting both HTTPS and SSH URLs
+func ParseRemote(remURL string) (Remote, error) {
 	origin := remURL
 
 	// Strip off any trailing .git suffix
@@ -83,7 +85,7 @@ func parseRemote(remURL string) (remote, error) {
 		// Translate a codecommit GRC URL into its HTTPS counterpart
 		var err error
 		if rem, err = translate.FromGRC(rem); err != nil {
-			return remote{}, err
+			return Remote{}, err
 		}
 
 		origin = rem
@@ -97,7 +99,7 @@ func parseRemote(remURL string) (remote, error) {
 
 	u, err := url.Parse(rem)
 	if err != nil {
-		return remote{}, err
+		return Remote{}, err
 	}
 
 	// Split into parts
@@ -106,7 +108,7 @@ func parseRemote(remURL string) (remote, error) {
 	if len(p) < 3 {
 		// This could be a custom Git server that doesn't follow the expected pattern.
 		// Don't fail, but return the raw origin for custom parsing
-		return remote{Origin: origin}, nil
+		return Remote{Origin: origin}, nil
 	}
 
 	// If the repository has a HTTP(S) origin, the host will have been correctly identified
@@ -125,7 +127,7 @@ func parseRemote(remURL string) (remote, error) {
 		path = name
 	}
 
-	return remote{
+	return Remote{
 		Origin: origin,
 		Owner:  owner,
 		Name:   name,
@@ -165,27 +167,37 @@ func detectSCM(host string, ctx *context.Context) context.SCMProvider {
 	return context.Unrecognised
 }
 
-func github(rem remote) context.SCM {
+func github(rem Remote) context.SCM {
 	url := fmt.Sprintf("https://%s/%s", rem.Host, rem.Path)
 
+	// GitHub Enterprise serves its API from a path on the same host
+	apiURL := "https://api.github.com"
+	if rem.Host != "github.com" {
+		apiURL = fmt.Sprintf("https://%s/api/v3", rem.Host)
+	}
+
 	return context.SCM{
 		Provider:  context.GitHub,
 		TagURL:    url + "/releases/tag/{{.Ref}}",
 		CommitURL: url + "/commit/{{.Hash}}",
+		APIURL:    apiURL,
+		Repo:      rem.Path,
 	}
 }
 
-func gitlab(rem remote) context.SCM {
+func gitlab(rem Remote) context.SCM {
 	url := fmt.Sprintf("https://%s/%s", rem.Host, rem.Path)
 
 	return context.SCM{
 		Provider:  context.GitLab,
 		TagURL:    url + "/-/tags/{{.Ref}}",
 		CommitURL: url + "/-/commit/{{.Hash}}",
+		APIURL:    fmt.Sprintf("https://%s/api/v4", rem.Host),
+		Repo:      rem.Path,
 	}
 }
 
-func codecommit(rem remote) context.SCM {
+func codecommit(rem Remote) context.SCM {
 	// CodeCommit URLs are a special case and require a region query parameter to be appended.
 	// Extract the region from the clone URL
 	t, _ := translate.RemoteHTTPS(rem.Origin)
@@ -200,7 +212,7 @@ func codecommit(rem remote) context.SCM {
 	}
 }
 
-func gitea(rem remote, u string) context.SCM {
+func gitea(rem Remote, u string) context.SCM {
 	scheme := u[:strings.Index(u, ":")]
 	url := fmt.Sprintf("%s://%s/%s", scheme, rem.Host, rem.Path)
 
@@ -208,6 +220,8 @@ func gitea(rem remote, u string) context.SCM {
 		Provider:  context.Gitea,
 		TagURL:    url + "/releases/tag/{{.Ref}}",
 		CommitURL: url + "/commit/{{.Hash}}",
+		APIURL:    fmt.Sprintf("%s://%s/api/v1", scheme, rem.Host),
+		Repo:      rem.Path,
 	}
 }
 
@@ -88,6 +88,8 @@ func TestRun_GiteaSelfHosted(t *testing.T) {
 	assert.Equal(t, ctx.SCM.Provider, context.Gitea)
 	assert.Equal(t, ctx.SCM.TagURL, "https://my.gitea.com/owner/repository/releases/tag/{{.Ref}}")
 	assert.Equal(t, ctx.SCM.CommitURL, "https://my.gitea.com/owner/repository/commit/{{.Hash}}")
+	assert.Equal(t, ctx.SCM.APIURL, "https://my.gitea.com/api/v1")
+	assert.Equal(t, ctx.SCM.Repo, "owner/repository")
 }
 
 func TestRun_GitHubEnterprise(t *testing.T) {
@@ -107,6 +109,8 @@ func TestRun_GitHubEnterprise(t *testing.T) {
 	assert.Equal(t, ctx.SCM.Provider, context.GitHub)
 	assert.Equal(t, ctx.SCM.TagURL, "https://my.github.com/owner/repository/releases/tag/{{.Ref}}")
 	assert.Equal(t, ctx.SCM.CommitURL, "https://my.github.com/owner/repository/commit/{{.Hash}}")
+	assert.Equal(t, ctx.SCM.APIURL, "https://my.github.com/api/v3")
+	assert.Equal(t, ctx.SCM.Repo, "owner/repository")
 }
 
 func TestRun_GitLabSelfHosted(t *testing.T) {
@@ -126,6 +130,8 @@ func TestRun_GitLabSelfHosted(t *testing.T) {
 	assert.Equal(t, ctx.SCM.Provider, context.GitLab)
 	assert.Equal(t, ctx.SCM.TagURL, "https://my.gitlab.com/owner/repository/-/tags/{{.Ref}}")
 	assert.Equal(t, ctx.SCM.CommitURL, "https://my.gitlab.com/owner/repository/-/commit/{{.Hash}}")
+	assert.Equal(t, ctx.SCM.APIURL, "https://my.gitlab.com/api/v4")
+	assert.Equal(t, ctx.SCM.Repo, "owner/repository")
 }
 
 func TestRun_UnrecognisedSCM(t *testing.T) {
@@ -0,0 +1,15 @@
+package sshimport
+
+import "errors"
+
+var (
+	// ErrSSHKeygenMissing is raised if ssh-keygen is not detected on the current $PATH
+	ErrSSHKeygenMissing = errors.New("ssh-keygen is not currently installed under $PATH")
+
+	// ErrKeyImport is raised when a provided SSH signing key fails to be imported
+	ErrKeyImport = errors.New(`uplift could not import SSH signing key. Check your key was exported correctly
+and is not protected by a passphrase.
+
+For further details visit: https://upliftci.dev/faq/sshimport
+`)
+)
@@ -0,0 +1,67 @@
+package sshimport
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/gitconfig"
+	"github.com/gembaadvantage/uplift/internal/ssh"
+)
+
+const envSSHSigningKey = "UPLIFT_SSH_SIGNING_KEY"
+
+// Task for importing an SSH signing key
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "importing ssh signing key"
+}
+
+// Skip running the task if no SSH signing key has been provided
+func (t Task) Skip(_ *context.Context) bool {
+	return strings.TrimSpace(os.Getenv(envSSHSigningKey)) == ""
+}
+
+// Run the task to import a provided SSH signing key and enable SSH signing of commits
+func (t Task) Run(ctx *context.Context) error {
+	log.Debug("checking if ssh-keygen is installed")
+	if !ssh.IsInstalled() {
+		return ErrSSHKeygenMissing
+	}
+
+	// Uplift only ever signs with the imported key, so trust it for any identity
+	log.Info("importing ssh signing key")
+	keyDetails, err := ssh.ImportKey(os.Getenv(envSSHSigningKey), "*")
+	if err != nil {
+		log.WithError(err).Debug("failed to import ssh signing key")
+		return ErrKeyImport
+	}
+
+	keyDir := filepath.Dir(keyDetails.PrivateKeyPath)
+	if ctx.KeepSigningKeys {
+		log.WithField("dir", keyDir).Warn("imported ssh signing key will not be removed")
+	} else {
+		// Signing must be disabled once the key is removed, otherwise any later
+		// commit will fail to resolve it
+		restoreConfig := gitconfig.Snapshot(ctx.GitClient,
+			"gpg.format", "user.signingKey", "gpg.ssh.allowedSignersFile", "commit.gpgsign")
+
+		ctx.Cleanup = append(ctx.Cleanup, func() error {
+			log.Debug("removing imported ssh signing key")
+			if err := restoreConfig(); err != nil {
+				return err
+			}
+			return os.RemoveAll(keyDir)
+		})
+	}
+
+	log.Info("setting git config to enable ssh signing")
+	return ctx.GitClient.ConfigSetL("gpg.format", "ssh",
+		"user.signingKey", keyDetails.PrivateKeyPath,
+		"gpg.ssh.allowedSignersFile", keyDetails.AllowedSignersPath,
+		"commit.gpgsign", "true")
+}
@@ -0,0 +1,239 @@
+package summary
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+)
+
+const (
+	// DefaultDotEnvPath is the default path of the dotenv file, compatible with
+	// a GitLab artifacts:reports:dotenv report
+	DefaultDotEnvPath = "uplift-release.env"
+
+	// DefaultManifestPath is the default path of the JSON manifest
+	DefaultManifestPath = "uplift-release.json"
+)
+
+// Summary contains the details of a release written by each writer
+type Summary struct {
+	Released        bool   `json:"released"`
+	Version         string `json:"version"`
+	Tag             string `json:"tag"`
+	PreviousVersion string `json:"previousVersion"`
+	Increment       string `json:"increment"`
+	Prerelease      string `json:"prerelease"`
+	Commit          string `json:"commit"`
+	Changelog       string `json:"changelog"`
+}
+
+// Task for writing a summary of the release, for use by any later
+// jobs within a CI pipeline
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "writing release summary"
+}
+
+// Skip running the task
+func (t Task) Skip(ctx *context.Context) bool {
+	if ctx.DryRun || ctx.Config.Summary == nil {
+		return true
+	}
+
+	s := ctx.Config.Summary
+	return !s.GitHub && !s.DotEnv.Enabled && !s.Manifest.Enabled
+}
+
+// Run the task
+func (t Task) Run(ctx *context.Context) error {
+	sum, err := newSummary(ctx)
+	if err != nil {
+		return err
+	}
+
+	cfg := ctx.Config.Summary
+	if cfg.GitHub {
+		if err := writeGitHub(sum); err != nil {
+			return err
+		}
+	}
+
+	if cfg.DotEnv.Enabled {
+		if err := writeDotEnv(path(cfg.DotEnv, DefaultDotEnvPath), sum); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Manifest.Enabled {
+		if err := writeManifest(path(cfg.Manifest, DefaultManifestPath), sum); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newSummary(ctx *context.Context) (Summary, error) {
+	sum := Summary{
+		Released:        !ctx.NoVersionChanged,
+		PreviousVersion: ctx.CurrentVersion.Raw,
+	}
+
+	if !sum.Released {
+		return sum, nil
+	}
+
+	sum.Version = strings.TrimPrefix(ctx.NextVersion.Raw, ctx.NextVersion.Prefix)
+	sum.Tag = ctx.NextVersion.Raw
+	sum.Increment = string(ctx.Increment)
+	sum.Prerelease = ctx.NextVersion.Prerelease
+	sum.Changelog = ctx.ReleaseNotes
+
+	sum.Commit = ctx.TagCommit
+	if sum.Commit == "" && ctx.GitClient != nil {
+		var err error
+		if sum.Commit, err = ctx.GitClient.Exec("git rev-parse HEAD"); err != nil {
+			return Summary{}, err
+		}
+	}
+
+	return sum, nil
+}
+
+func path(f config.SummaryFile, def string) string {
+	if f.Path == "" {
+		return def
+	}
+	return f.Path
+}
+
+// Single line values, written by every key=value based writer
+func (s Summary) values() [][2]string {
+	return [][2]string{
+		{"released", strconv.FormatBool(s.Released)},
+		{"version", s.Version},
+		{"tag", s.Tag},
+		{"previous_version", s.PreviousVersion},
+		{"increment", s.Increment},
+		{"prerelease", s.Prerelease},
+		{"commit", s.Commit},
+	}
+}
+
+func writeGitHub(sum Summary) error {
+	if out := os.Getenv("GITHUB_OUTPUT"); out != "" {
+		var b strings.Builder
+		for _, v := range sum.values() {
+			fmt.Fprintf(&b, "%s=%s\n", v[0], v[1])
+		}
+
+		// Multiline values must be written using a unique delimiter
+		delim, err := delimiter()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "changelog<<%s\n%s\n%s\n", delim, strings.TrimSpace(sum.Changelog), delim)
+
+		if err := appendFile(out, b.String()); err != nil {
+			return err
+		}
+		log.WithField("path", out).Info("written GitHub outputs")
+	} else {
+		log.Debug("GITHUB_OUTPUT not set, skipping GitHub outputs")
+	}
+
+	if out := os.Getenv("GITHUB_STEP_SUMMARY"); out != "" {
+		if err := appendFile(out, stepSummary(sum)); err != nil {
+			return err
+		}
+		log.WithField("path", out).Info("written GitHub step summary")
+	} else {
+		log.Debug("GITHUB_STEP_SUMMARY not set, skipping GitHub step summary")
+	}
+
+	return nil
+}
+
+func stepSummary(sum Summary) string {
+	if !sum.Released {
+		return "## No release\n\nNo changes were detected that would trigger a new release.\n"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Released %s\n\n", sum.Tag)
+	b.WriteString("| | |\n| --- | --- |\n")
+	fmt.Fprintf(&b, "| Previous version | `%s` |\n", sum.PreviousVersion)
+	fmt.Fprintf(&b, "| Increment | %s |\n", sum.Increment)
+	fmt.Fprintf(&b, "| Commit | `%s` |\n", sum.Commit)
+
+	if changelog := strings.TrimSpace(sum.Changelog); changelog != "" {
+		fmt.Fprintf(&b, "\n%s\n", changelog)
+	}
+	return b.String()
+}
+
+func writeDotEnv(path string, sum Summary) error {
+	// A dotenv report does not support multiline values, so the changelog is excluded
+	var b strings.Builder
+	for _, v := range sum.values() {
+		fmt.Fprintf(&b, "UPLIFT_%s=%s\n", strings.ToUpper(v[0]), v[1])
+	}
+
+	if err := writeFile(path, []byte(b.String())); err != nil {
+		return err
+	}
+	log.WithField("path", path).Info("written dotenv file")
+	return nil
+}
+
+func writeManifest(path string, sum Summary) error {
+	data, err := json.MarshalIndent(sum, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(path, append(data, '\n')); err != nil {
+		return err
+	}
+	log.WithField("path", path).Info("written release manifest")
+	return nil
+}
+
+func delimiter() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "UPLIFT_EOF_" + hex.EncodeToString(b), nil
+}
+
+func appendFile(path, content string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(content)
+	return err
+}
+
+func writeFile(path string, data []byte) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,179 @@
+package summary
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/config"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/trace"
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "writing release summary", Task{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  *context.Context
+	}{
+		{
+			name: "NoConfig",
+			ctx:  &context.Context{},
+		},
+		{
+			name: "NoWritersEnabled",
+			ctx: &context.Context{
+				Config: config.Uplift{Summary: &config.Summary{}},
+			},
+		},
+		{
+			name: "DryRun",
+			ctx: &context.Context{
+				Config: config.Uplift{Summary: &config.Summary{GitHub: true}},
+				DryRun: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, Task{}.Skip(tt.ctx))
+		})
+	}
+}
+
+func TestRun_GitHub(t *testing.T) {
+	gittest.InitRepository(t)
+
+	dir := t.TempDir()
+	output := filepath.Join(dir, "output")
+	stepSummary := filepath.Join(dir, "summary")
+	t.Setenv("GITHUB_OUTPUT", output)
+	t.Setenv("GITHUB_STEP_SUMMARY", stepSummary)
+
+	ctx := releaseContext(t, config.Summary{GitHub: true})
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+
+	hash := gittest.LastCommit(t).Hash
+	assert.Regexp(t, regexp.MustCompile(`^released=true
+version=1.1.0
+tag=v1.1.0
+previous_version=v1.0.0
+increment=Minor
+prerelease=
+commit=`+hash+`
+changelog<<(UPLIFT_EOF_[0-9a-f]+)
+## v1.1.0
+
+- a new feature
+UPLIFT_EOF_[0-9a-f]+
+$`), string(data))
+
+	data, err = os.ReadFile(stepSummary)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "## Released v1.1.0")
+	assert.Contains(t, string(data), "| Commit | `"+hash+"` |")
+	assert.Contains(t, string(data), "- a new feature")
+}
+
+func TestRun_GitHubNotSet(t *testing.T) {
+	gittest.InitRepository(t)
+	t.Setenv("GITHUB_OUTPUT", "")
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+
+	err := Task{}.Run(releaseContext(t, config.Summary{GitHub: true}))
+	require.NoError(t, err)
+}
+
+func TestRun_DotEnv(t *testing.T) {
+	gittest.InitRepository(t)
+
+	ctx := releaseContext(t, config.Summary{DotEnv: config.SummaryFile{Enabled: true}})
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(DefaultDotEnvPath)
+	require.NoError(t, err)
+	assert.Equal(t, `UPLIFT_RELEASED=true
+UPLIFT_VERSION=1.1.0
+UPLIFT_TAG=v1.1.0
+UPLIFT_PREVIOUS_VERSION=v1.0.0
+UPLIFT_INCREMENT=Minor
+UPLIFT_PRERELEASE=
+UPLIFT_COMMIT=`+gittest.LastCommit(t).Hash+"\n", string(data))
+}
+
+func TestRun_Manifest(t *testing.T) {
+	gittest.InitRepository(t)
+
+	ctx := releaseContext(t, config.Summary{
+		Manifest: config.SummaryFile{Enabled: true, Path: "release/manifest.json"},
+	})
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("release/manifest.json")
+	require.NoError(t, err)
+
+	var sum Summary
+	require.NoError(t, json.Unmarshal(data, &sum))
+	assert.Equal(t, Summary{
+		Released:        true,
+		Version:         "1.1.0",
+		Tag:             "v1.1.0",
+		PreviousVersion: "v1.0.0",
+		Increment:       "Minor",
+		Commit:          gittest.LastCommit(t).Hash,
+		Changelog:       "## v1.1.0\n\n- a new feature\n",
+	}, sum)
+}
+
+func TestRun_NoRelease(t *testing.T) {
+	gittest.InitRepository(t)
+
+	ctx := &context.Context{
+		Config: config.Uplift{
+			Summary: &config.Summary{Manifest: config.SummaryFile{Enabled: true}},
+		},
+		CurrentVersion:   semver.Version{Raw: "v1.0.0"},
+		NoVersionChanged: true,
+	}
+	err := Task{}.Run(ctx)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(DefaultManifestPath)
+	require.NoError(t, err)
+
+	var sum Summary
+	require.NoError(t, json.Unmarshal(data, &sum))
+	assert.Equal(t, Summary{PreviousVersion: "v1.0.0"}, sum)
+}
+
+func releaseContext(t *testing.T, cfg config.Summary) *context.Context {
+	t.Helper()
+
+	gitc, err := git.NewClient()
+	require.NoError(t, err)
+
+	return &context.Context{
+		Config:         config.Uplift{Summary: &cfg},
+		CurrentVersion: semver.Version{Prefix: "v", Major: 1, Raw: "v1.0.0"},
+		NextVersion:    semver.Version{Prefix: "v", Major: 1, Minor: 1, Raw: "v1.1.0"},
+		Increment:      semver.MinorIncrement,
+		GitClient:      trace.NewGitClient(gitc, nil),
+		ReleaseNotes:   "## v1.1.0\n\n- a new feature\n",
+	}
+}
@@ -0,0 +1,36 @@
+package verifysig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gembaadvantage/uplift/internal/signature"
+)
+
+// ErrUnverifiedSignatures is raised if any commit within the release has not been signed
+// by an allowed key
+type ErrUnverifiedSignatures struct {
+	commits []signature.Signature
+}
+
+// Error returns a formatted message of the current error
+func (e ErrUnverifiedSignatures) Error() string {
+	var report strings.Builder
+	for _, c := range e.commits {
+		switch {
+		case c.Status == signature.Unsigned:
+			fmt.Fprintf(&report, "  %s  not signed\n", c.Hash[:7])
+		case !c.Valid():
+			fmt.Fprintf(&report, "  %s  %s signature from key %s\n", c.Hash[:7], c.Status, c.Key)
+		default:
+			fmt.Fprintf(&report, "  %s  signed by key %s, which is not allowed\n", c.Hash[:7], c.Key)
+		}
+	}
+
+	return fmt.Sprintf(`uplift requires every commit within a release to be signed by an allowed key. The following
+commits failed verification:
+%s
+Please check and resolve the signatures of these commits before retrying. For further
+details visit: https://upliftci.dev/faq/gitsignatures
+`, report.String())
+}
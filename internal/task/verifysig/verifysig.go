@@ -0,0 +1,65 @@
+package verifysig
+
+import (
+	"github.com/apex/log"
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/signature"
+	git "github.com/purpleclay/gitz"
+)
+
+// Task for verifying the signature of every commit within the release
+type Task struct{}
+
+// String generates a string representation of the task
+func (t Task) String() string {
+	return "verifying commit signatures"
+}
+
+// Skip running the task if signature verification is disabled or there is
+// no release to verify
+func (t Task) Skip(ctx *context.Context) bool {
+	return ctx.VerifySignatures == nil || ctx.NoVersionChanged
+}
+
+// Run the task, verifying that every commit between the current and next
+// version has been signed by an allowed key. Must run after both versions have
+// been resolved, to ensure the same range of commits is used by the release
+func (t Task) Run(ctx *context.Context) error {
+	revRange := releaseRange(ctx)
+	log.WithField("range", revRange).Debug("verifying commit signatures")
+	sigs, err := signature.Log(ctx.GitClient, revRange, ctx.VerifySignatures.AllowedKeys)
+	if err != nil {
+		return err
+	}
+
+	var unverified []signature.Signature
+	for _, sig := range sigs {
+		if !sig.Verified {
+			unverified = append(unverified, sig)
+		}
+	}
+
+	if len(unverified) > 0 {
+		return ErrUnverifiedSignatures{commits: unverified}
+	}
+
+	log.WithField("commits", len(sigs)).Info("verified all commit signatures")
+	return nil
+}
+
+// releaseRange identifies the range of commits within the release. If the next
+// version has already been tagged, as is the case when generating a changelog,
+// the range ends at that tag rather than HEAD
+func releaseRange(ctx *context.Context) string {
+	to := git.HeadRef
+	if ctx.NextVersion.Raw != "" {
+		if _, err := ctx.GitClient.Exec("git rev-parse -q --verify refs/tags/" + ctx.NextVersion.Raw); err == nil {
+			to = ctx.NextVersion.Raw
+		}
+	}
+
+	if ctx.CurrentVersion.Raw == "" {
+		return to
+	}
+	return ctx.CurrentVersion.Raw + ".." + to
+}
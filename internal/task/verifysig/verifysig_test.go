@@ -0,0 +1,97 @@
+package verifysig
+
+import (
+	"testing"
+
+	"github.com/gembaadvantage/uplift/internal/context"
+	"github.com/gembaadvantage/uplift/internal/semver"
+	"github.com/gembaadvantage/uplift/internal/ssh/sshtest"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "verifying commit signatures", Task{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	assert.True(t, Task{}.Skip(&context.Context{}))
+}
+
+func TestSkip_NoVersionChanged(t *testing.T) {
+	assert.True(t, Task{}.Skip(&context.Context{
+		NoVersionChanged: true,
+		VerifySignatures: &context.VerifySignatures{},
+	}))
+}
+
+func TestRun_VerifySignatures(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("(tag: 0.1.0) feat: an unsigned feature"))
+	fingerprint := sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "fix: a signed fix")
+
+	err := Task{}.Run(&context.Context{
+		CurrentVersion: semver.Version{Raw: "0.1.0"},
+		VerifySignatures: &context.VerifySignatures{
+			AllowedKeys: []string{fingerprint},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestRun_VerifySignaturesUpToNextTag(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("(tag: 0.1.0) feat: an unsigned feature"))
+	sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "fix: a signed fix")
+	gittest.Tag(t, "0.1.1")
+	gittest.MustExec(t, "git -c commit.gpgsign=false commit --allow-empty -m 'fix: an unreleased fix'")
+
+	err := Task{}.Run(&context.Context{
+		CurrentVersion:   semver.Version{Raw: "0.1.0"},
+		NextVersion:      semver.Version{Raw: "0.1.1"},
+		VerifySignatures: &context.VerifySignatures{},
+	})
+	assert.NoError(t, err)
+}
+
+func TestRun_VerifySignaturesUnsigned(t *testing.T) {
+	gittest.InitRepository(t, gittest.WithLog("(tag: 0.1.0) feat: an unsigned feature"))
+	sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "fix: a signed fix")
+	gittest.MustExec(t, "git -c commit.gpgsign=false commit --allow-empty -m 'fix: an unsigned fix'")
+	unsigned := gittest.LastCommit(t)
+
+	err := Task{}.Run(&context.Context{
+		CurrentVersion:   semver.Version{Raw: "0.1.0"},
+		NextVersion:      semver.Version{Raw: "0.1.1"},
+		VerifySignatures: &context.VerifySignatures{},
+	})
+	assert.EqualError(t, err, `uplift requires every commit within a release to be signed by an allowed key. The following
+commits failed verification:
+  `+unsigned.AbbrevHash+`  not signed
+
+Please check and resolve the signatures of these commits before retrying. For further
+details visit: https://upliftci.dev/faq/gitsignatures
+`)
+}
+
+func TestRun_VerifySignaturesNotAllowed(t *testing.T) {
+	gittest.InitRepository(t)
+	fingerprint := sshtest.ConfigureSigning(t)
+	gittest.MustExec(t, "git config commit.gpgsign true")
+	gittest.CommitEmpty(t, "fix: a signed fix")
+
+	err := Task{}.Run(&context.Context{
+		VerifySignatures: &context.VerifySignatures{
+			AllowedKeys: []string{"SHA256:unknown"},
+		},
+	})
+
+	var sigErr ErrUnverifiedSignatures
+	require.ErrorAs(t, err, &sigErr)
+	assert.Contains(t, err.Error(), "signed by key "+fingerprint+", which is not allowed")
+}
@@ -0,0 +1,183 @@
+package trace
+
+import (
+	"context"
+	"strings"
+
+	git "github.com/purpleclay/gitz"
+)
+
+// GitClient wraps a git client, recording a span for every git operation.
+// Each span is started within the context returned at the time of the
+// operation, ensuring it becomes a child of the task that executed it
+type GitClient struct {
+	*git.Client
+
+	current func() context.Context
+}
+
+// NewGitClient wraps a git client, tracing every git operation within the
+// context returned by current. A nil client is never wrapped. Just like a git
+// client, every traced operation is safe to call on a nil client
+func NewGitClient(gc *git.Client, current func() context.Context) *GitClient {
+	if gc == nil {
+		return nil
+	}
+	return &GitClient{Client: gc, current: current}
+}
+
+func (c *GitClient) git() *git.Client {
+	if c == nil {
+		return nil
+	}
+	return c.Client
+}
+
+func (c *GitClient) start(name string) *Span {
+	if c == nil || c.current == nil {
+		return nil
+	}
+
+	_, span := Start(c.current(), "git "+name)
+	return span
+}
+
+// Exec a git command, naming its span after the git subcommand
+func (c *GitClient) Exec(cmd string) (string, error) {
+	span := c.start(subcommand(cmd))
+	out, err := c.git().Exec(cmd)
+	span.End(err)
+	return out, err
+}
+
+// subcommand identifies the git subcommand being executed, e.g. rev-parse
+func subcommand(cmd string) string {
+	fields := strings.Fields(cmd)
+	if len(fields) > 0 && fields[0] == "git" {
+		fields = fields[1:]
+	}
+
+	for i := 0; i < len(fields); i++ {
+		if fields[i] == "-c" || fields[i] == "-C" {
+			i++
+			continue
+		}
+
+		if !strings.HasPrefix(fields[i], "-") {
+			return fields[i]
+		}
+	}
+	return "exec"
+}
+
+// Repository captures details about the current repository
+func (c *GitClient) Repository() (git.Repository, error) {
+	span := c.start("repository")
+	repo, err := c.git().Repository()
+	span.End(err)
+	return repo, err
+}
+
+// Commit any staged changes
+func (c *GitClient) Commit(msg string, opts ...git.CommitOption) (string, error) {
+	span := c.start("commit")
+	out, err := c.git().Commit(msg, opts...)
+	span.End(err)
+	return out, err
+}
+
+// Config retrieves all git config for the current repository
+func (c *GitClient) Config() (map[string]string, error) {
+	span := c.start("config")
+	cfg, err := c.git().Config()
+	span.End(err)
+	return cfg, err
+}
+
+// ConfigSetL sets git config local to the current repository
+func (c *GitClient) ConfigSetL(pairs ...string) error {
+	span := c.start("config")
+	err := c.git().ConfigSetL(pairs...)
+	span.End(err)
+	return err
+}
+
+// Fetch changes from the remote
+func (c *GitClient) Fetch(opts ...git.FetchOption) (string, error) {
+	span := c.start("fetch")
+	out, err := c.git().Fetch(opts...)
+	span.End(err)
+	return out, err
+}
+
+// Log retrieves the commit log of the current repository
+func (c *GitClient) Log(opts ...git.LogOption) (*git.Log, error) {
+	span := c.start("log")
+	l, err := c.git().Log(opts...)
+	span.End(err)
+	return l, err
+}
+
+// Push changes to the remote
+func (c *GitClient) Push(opts ...git.PushOption) (string, error) {
+	span := c.start("push")
+	out, err := c.git().Push(opts...)
+	span.End(err)
+	return out, err
+}
+
+// ShowTags retrieves details about each tag
+func (c *GitClient) ShowTags(refs ...string) (map[string]git.TagDetails, error) {
+	span := c.start("show")
+	tags, err := c.git().ShowTags(refs...)
+	span.End(err)
+	return tags, err
+}
+
+// Stage changes to the index
+func (c *GitClient) Stage(opts ...git.StageOption) (string, error) {
+	span := c.start("add")
+	out, err := c.git().Stage(opts...)
+	span.End(err)
+	return out, err
+}
+
+// Staged lists all staged changes
+func (c *GitClient) Staged() ([]string, error) {
+	span := c.start("diff")
+	staged, err := c.git().Staged()
+	span.End(err)
+	return staged, err
+}
+
+// PorcelainStatus retrieves the status of the working tree
+func (c *GitClient) PorcelainStatus(opts ...git.StatusOption) ([]git.FileStatus, error) {
+	span := c.start("status")
+	statuses, err := c.git().PorcelainStatus(opts...)
+	span.End(err)
+	return statuses, err
+}
+
+// Tag the current commit
+func (c *GitClient) Tag(tag string, opts ...git.CreateTagOption) (string, error) {
+	span := c.start("tag")
+	out, err := c.git().Tag(tag, opts...)
+	span.End(err)
+	return out, err
+}
+
+// Tags lists all tags within the current repository
+func (c *GitClient) Tags(opts ...git.ListTagsOption) ([]string, error) {
+	span := c.start("tag")
+	tags, err := c.git().Tags(opts...)
+	span.End(err)
+	return tags, err
+}
+
+// DeleteTag deletes a tag
+func (c *GitClient) DeleteTag(tag string, opts ...git.DeleteTagsOption) (string, error) {
+	span := c.start("tag")
+	out, err := c.git().DeleteTag(tag, opts...)
+	span.End(err)
+	return out, err
+}
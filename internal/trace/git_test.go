@@ -0,0 +1,72 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	git "github.com/purpleclay/gitz"
+	"github.com/purpleclay/gitz/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitClient(t *testing.T) {
+	gittest.InitRepository(t)
+
+	gc, err := git.NewClient()
+	require.NoError(t, err)
+
+	tracer := New()
+	ctx, root := Start(WithTracer(context.Background(), tracer), "task")
+
+	gitc := NewGitClient(gc, func() context.Context { return ctx })
+	_, err = gitc.Exec("git rev-parse HEAD")
+	require.NoError(t, err)
+	_, err = gitc.Tags()
+	require.NoError(t, err)
+	_, err = gitc.Exec("git rev-parse missing")
+	require.Error(t, err)
+	root.End(nil)
+
+	spans := tracer.Spans()
+	require.Len(t, spans, 4)
+	assert.Equal(t, "git rev-parse", spans[1].Name)
+	assert.Equal(t, "git tag", spans[2].Name)
+	assert.Equal(t, "git rev-parse", spans[3].Name)
+	assert.NotEmpty(t, spans[3].Error)
+
+	for _, s := range spans[1:] {
+		assert.Equal(t, root.ID, s.ParentID)
+	}
+}
+
+func TestGitClient_NoTracer(t *testing.T) {
+	gittest.InitRepository(t)
+
+	gc, err := git.NewClient()
+	require.NoError(t, err)
+
+	_, err = NewGitClient(gc, nil).Exec("git rev-parse HEAD")
+	require.NoError(t, err)
+}
+
+func TestNewGitClient_Nil(t *testing.T) {
+	assert.Nil(t, NewGitClient(nil, nil))
+}
+
+func TestSubcommand(t *testing.T) {
+	tests := []struct {
+		cmd      string
+		expected string
+	}{
+		{cmd: "git rev-parse HEAD", expected: "rev-parse"},
+		{cmd: "git -c core.hooksPath=/dev/null push --atomic origin main", expected: "push"},
+		{cmd: "git --no-pager log", expected: "log"},
+		{cmd: "git", expected: "exec"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cmd, func(t *testing.T) {
+			assert.Equal(t, tt.expected, subcommand(tt.cmd))
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gembaadvantage/uplift/internal/version"
+)
+
+const (
+	// ServiceName identifies uplift as the source of all exported spans
+	ServiceName = "uplift"
+
+	scopeName = "github.com/gembaadvantage/uplift"
+
+	// The path used by an OpenTelemetry collector for receiving traces over HTTP
+	tracesPath = "/v1/traces"
+
+	// As defined by the OTLP specification
+	spanKindInternal = 1
+	statusCodeOK     = 1
+	statusCodeError  = 2
+)
+
+// DefaultExportTimeout is the maximum duration of an export to a collector
+var DefaultExportTimeout = 10 * time.Second
+
+type otlpTrace struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// MarshalOTLP encodes all spans as an OTLP/JSON trace export request, as
+// understood by any OpenTelemetry collector
+func MarshalOTLP(spans []Span) ([]byte, error) {
+	ospans := make([]otlpSpan, 0, len(spans))
+	for _, s := range spans {
+		status := otlpStatus{Code: statusCodeOK}
+		if s.Error != "" {
+			status = otlpStatus{Code: statusCodeError, Message: s.Error}
+		}
+
+		end := s.EndTime
+		if end.IsZero() {
+			end = s.StartTime
+		}
+
+		ospans = append(ospans, otlpSpan{
+			TraceID:           s.TraceID,
+			SpanID:            s.ID,
+			ParentSpanID:      s.ParentID,
+			Name:              s.Name,
+			Kind:              spanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+			Attributes:        attributes(s.Attributes),
+			Status:            status,
+		})
+	}
+
+	resource := map[string]string{"service.name": ServiceName}
+	if v := version.Short(); v != "" {
+		resource["service.version"] = v
+	}
+
+	return json.Marshal(otlpTrace{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{Attributes: attributes(resource)},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: scopeName, Version: version.Short()},
+						Spans: ospans,
+					},
+				},
+			},
+		},
+	})
+}
+
+func attributes(attrs map[string]string) []otlpAttribute {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	oattrs := make([]otlpAttribute, 0, len(keys))
+	for _, k := range keys {
+		oattrs = append(oattrs, otlpAttribute{Key: k, Value: otlpValue{StringValue: attrs[k]}})
+	}
+	return oattrs
+}
+
+// WriteFile writes all spans to a file in the OTLP/JSON format
+func WriteFile(path string, spans []Span) error {
+	data, err := MarshalOTLP(spans)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Export sends all spans to an OpenTelemetry collector using OTLP/HTTP with
+// a JSON encoding. If the endpoint does not include the traces path of
+// /v1/traces, it will be appended
+func Export(ctx context.Context, endpoint string, spans []Span) error {
+	data, err := MarshalOTLP(spans)
+	if err != nil {
+		return err
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultExportTimeout)
+	defer cancel()
+
+	url := endpoint
+	if !strings.HasSuffix(strings.TrimSuffix(url, "/"), tracesPath) {
+		url = strings.TrimSuffix(url, "/") + tracesPath
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("collector rejected trace with status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSpans() []Span {
+	start := time.Unix(1700000000, 0)
+	return []Span{
+		{
+			TraceID:   "0af7651916cd43dd8448eb211c80319c",
+			ID:        "b7ad6b7169203331",
+			Name:      "uplift",
+			StartTime: start,
+			EndTime:   start.Add(time.Second),
+		},
+		{
+			TraceID:    "0af7651916cd43dd8448eb211c80319c",
+			ID:         "00f067aa0ba902b7",
+			ParentID:   "b7ad6b7169203331",
+			Name:       "git push",
+			StartTime:  start,
+			EndTime:    start.Add(500 * time.Millisecond),
+			Attributes: map[string]string{"refs": "main"},
+			Error:      "rejected",
+		},
+	}
+}
+
+func TestMarshalOTLP(t *testing.T) {
+	data, err := MarshalOTLP(testSpans())
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+  "resourceSpans": [
+    {
+      "resource": {
+        "attributes": [
+          {"key": "service.name", "value": {"stringValue": "uplift"}}
+        ]
+      },
+      "scopeSpans": [
+        {
+          "scope": {"name": "github.com/gembaadvantage/uplift"},
+          "spans": [
+            {
+              "traceId": "0af7651916cd43dd8448eb211c80319c",
+              "spanId": "b7ad6b7169203331",
+              "name": "uplift",
+              "kind": 1,
+              "startTimeUnixNano": "1700000000000000000",
+              "endTimeUnixNano": "1700000001000000000",
+              "status": {"code": 1}
+            },
+            {
+              "traceId": "0af7651916cd43dd8448eb211c80319c",
+              "spanId": "00f067aa0ba902b7",
+              "parentSpanId": "b7ad6b7169203331",
+              "name": "git push",
+              "kind": 1,
+              "startTimeUnixNano": "1700000000000000000",
+              "endTimeUnixNano": "1700000000500000000",
+              "attributes": [
+                {"key": "refs", "value": {"stringValue": "main"}}
+              ],
+              "status": {"code": 2, "message": "rejected"}
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`, string(data))
+}
+
+func TestWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+	require.NoError(t, WriteFile(path, testSpans()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, json.Valid(data))
+}
+
+func TestExport(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+	}{
+		{
+			name:     "BaseURL",
+			endpoint: "",
+		},
+		{
+			name:     "TrailingSlash",
+			endpoint: "/",
+		},
+		{
+			name:     "TracesPath",
+			endpoint: "/v1/traces",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				path, contentType string
+				body              []byte
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				contentType = r.Header.Get("Content-Type")
+				body, _ = io.ReadAll(r.Body)
+			}))
+			defer srv.Close()
+
+			err := Export(context.Background(), srv.URL+tt.endpoint, testSpans())
+			require.NoError(t, err)
+
+			assert.Equal(t, "/v1/traces", path)
+			assert.Equal(t, "application/json", contentType)
+
+			expected, _ := MarshalOTLP(testSpans())
+			assert.JSONEq(t, string(expected), string(body))
+		})
+	}
+}
+
+func TestExport_Rejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("invalid trace\n"))
+	}))
+	defer srv.Close()
+
+	err := Export(context.Background(), srv.URL, testSpans())
+	require.EqualError(t, err, "collector rejected trace with status 400: invalid trace")
+}
@@ -0,0 +1,179 @@
+package trace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+type tracerKey struct{}
+
+type spanKey struct{}
+
+// Tracer records every span started during the execution of a workflow. All
+// spans share the same trace ID
+type Tracer struct {
+	mu      sync.Mutex
+	traceID string
+	spans   []*Span
+}
+
+// New creates a tracer with a random trace ID
+func New() *Tracer {
+	return &Tracer{traceID: newID(16)}
+}
+
+// WithTracer returns a copy of the parent context, within which all spans will
+// be recorded by the tracer
+func WithTracer(parent context.Context, t *Tracer) context.Context {
+	if parent == nil {
+		parent = context.Background()
+	}
+	return context.WithValue(parent, tracerKey{}, t)
+}
+
+// Span captures the duration of a single operation. A span started within
+// the context of another span becomes its child
+type Span struct {
+	TraceID    string
+	ID         string
+	ParentID   string
+	Name       string
+	StartTime  time.Time
+	EndTime    time.Time
+	Attributes map[string]string
+	Error      string
+
+	tracer *Tracer
+}
+
+// Start a new span. If the context has no tracer, a nil span is returned,
+// which is safe to use and records nothing
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	if ctx == nil {
+		return ctx, nil
+	}
+
+	t, ok := ctx.Value(tracerKey{}).(*Tracer)
+	if !ok {
+		return ctx, nil
+	}
+
+	s := &Span{
+		TraceID:    t.traceID,
+		ID:         newID(8),
+		Name:       name,
+		StartTime:  time.Now(),
+		Attributes: map[string]string{},
+		tracer:     t,
+	}
+
+	if parent, ok := ctx.Value(spanKey{}).(*Span); ok {
+		s.ParentID = parent.ID
+	}
+
+	t.mu.Lock()
+	t.spans = append(t.spans, s)
+	t.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// SetAttribute records a key value pair against the span
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.Attributes[key] = value
+}
+
+// End the span, recording any error that occurred. Ending a span more
+// than once has no effect
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+
+	if !s.EndTime.IsZero() {
+		return
+	}
+
+	s.EndTime = time.Now()
+	if err != nil {
+		s.Error = err.Error()
+	}
+}
+
+// Duration of the span. A span that has not ended has no duration
+func (s Span) Duration() time.Duration {
+	if s.EndTime.IsZero() {
+		return 0
+	}
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// Spans returns a copy of every span recorded by the tracer, in the order
+// they were started
+func (t *Tracer) Spans() []Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	spans := make([]Span, 0, len(t.spans))
+	for _, s := range t.spans {
+		cp := *s
+		cp.Attributes = make(map[string]string, len(s.Attributes))
+		for k, v := range s.Attributes {
+			cp.Attributes[k] = v
+		}
+		spans = append(spans, cp)
+	}
+	return spans
+}
+
+// WriteTable writes the duration of every span as a table, with each child
+// span indented beneath its parent
+func WriteTable(w io.Writer, spans []Span) error {
+	depths := map[string]int{}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tDURATION\tSTATUS")
+
+	for _, s := range spans {
+		depth := 0
+		if d, ok := depths[s.ParentID]; ok {
+			depth = d + 1
+		}
+		depths[s.ID] = depth
+
+		status := "ok"
+		if s.Error != "" {
+			status = "failed"
+		}
+
+		fmt.Fprintf(tw, "%s%s\t%s\t%s\n",
+			strings.Repeat("  ", depth),
+			s.Name,
+			s.Duration().Round(time.Millisecond),
+			status)
+	}
+
+	return tw.Flush()
+}
+
+func newID(n int) string {
+	b := make([]byte, n)
+	// Never returns an error, as documented by crypto/rand
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
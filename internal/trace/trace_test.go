@@ -0,0 +1,111 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStart_NoTracer(t *testing.T) {
+	ctx, span := Start(context.Background(), "task")
+	assert.Nil(t, span)
+	assert.NotNil(t, ctx)
+
+	// A nil span must be safe to use
+	span.SetAttribute("key", "value")
+	span.End(errors.New("ignored"))
+}
+
+func TestStart_NilContext(t *testing.T) {
+	var ctx context.Context
+	_, span := Start(ctx, "task")
+	assert.Nil(t, span)
+}
+
+func TestStart_ChildSpans(t *testing.T) {
+	tracer := New()
+	ctx := WithTracer(context.Background(), tracer)
+
+	pctx, parent := Start(ctx, "parent")
+	_, child := Start(pctx, "child")
+	child.SetAttribute("key", "value")
+	child.End(errors.New("child failed"))
+	parent.End(nil)
+
+	spans := tracer.Spans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "parent", spans[0].Name)
+	assert.Empty(t, spans[0].ParentID)
+	assert.Empty(t, spans[0].Error)
+	assert.Len(t, spans[0].TraceID, 32)
+	assert.Len(t, spans[0].ID, 16)
+
+	assert.Equal(t, "child", spans[1].Name)
+	assert.Equal(t, spans[0].ID, spans[1].ParentID)
+	assert.Equal(t, spans[0].TraceID, spans[1].TraceID)
+	assert.Equal(t, "child failed", spans[1].Error)
+	assert.Equal(t, map[string]string{"key": "value"}, spans[1].Attributes)
+}
+
+func TestSpan_EndOnce(t *testing.T) {
+	tracer := New()
+	_, span := Start(WithTracer(context.Background(), tracer), "task")
+
+	span.End(nil)
+	end := tracer.Spans()[0].EndTime
+	span.End(errors.New("too late"))
+
+	spans := tracer.Spans()
+	assert.Equal(t, end, spans[0].EndTime)
+	assert.Empty(t, spans[0].Error)
+}
+
+func TestStart_Concurrent(t *testing.T) {
+	tracer := New()
+	ctx, parent := Start(WithTracer(context.Background(), tracer), "group")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, span := Start(ctx, "hook")
+			span.End(nil)
+		}()
+	}
+	wg.Wait()
+	parent.End(nil)
+
+	spans := tracer.Spans()
+	require.Len(t, spans, 11)
+	for _, s := range spans[1:] {
+		assert.Equal(t, parent.ID, s.ParentID)
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	start := time.Now()
+	spans := []Span{
+		{ID: "1", Name: "uplift", StartTime: start, EndTime: start.Add(3 * time.Second)},
+		{ID: "2", ParentID: "1", Name: "fetching all tags", StartTime: start, EndTime: start.Add(2500 * time.Millisecond)},
+		{ID: "3", ParentID: "2", Name: "git fetch", StartTime: start, EndTime: start.Add(2400 * time.Millisecond), Error: "timeout"},
+		{ID: "4", ParentID: "1", Name: "tagging repository", StartTime: start, EndTime: start.Add(12 * time.Millisecond)},
+	}
+
+	var buf strings.Builder
+	require.NoError(t, WriteTable(&buf, spans))
+
+	assert.Equal(t, `NAME                  DURATION  STATUS
+uplift                3s        ok
+  fetching all tags   2.5s      ok
+    git fetch         2.4s      failed
+  tagging repository  12ms      ok
+`, buf.String())
+}